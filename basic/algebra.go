@@ -0,0 +1,22 @@
+package basic
+
+import "github.com/brianlewyn/go-calculator/internal/math"
+
+// Roots returns the real and complex roots of a polynomial, whose coefficients go from
+// the highest degree to the constant term, sorted by their real part and then by their imaginary one,
+// and nil, otherwise it returns nil and an error, which is also the case if it does not converge.
+//
+//	x^2 - 3*x + 2 => Roots(1, -3, 2) = [1, 2]
+//	x^2 + 1 => Roots(1, 0, 1) = [-i, i]
+func Roots(coefficients ...float64) ([]complex128, error) {
+	return math.Roots(coefficients)
+}
+
+// LinSolve returns the solution x of the square system of linear equations a*x = b and nil,
+// where each row of a are the coefficients of one equation, otherwise it returns nil and an error,
+// which is also the case if a is singular.
+//
+//	2x + y = 3, x + 3y = 5 => LinSolve([][]float64{{2, 1}, {1, 3}}, []float64{3, 5}) = [0.8, 1.4]
+func LinSolve(a [][]float64, b []float64) ([]float64, error) {
+	return math.LinSolve(a, b)
+}
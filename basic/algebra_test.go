@@ -0,0 +1,52 @@
+package basic
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoots(t *testing.T) {
+	tests := []struct {
+		coefficients []float64
+		want         []complex128
+	}{
+		{coefficients: []float64{1, -3, 2}, want: []complex128{1, 2}},
+		{coefficients: []float64{1, 0, 1}, want: []complex128{-1i, 1i}},
+		{coefficients: []float64{2, -12, 22, -12}, want: []complex128{1, 2, 3}},
+		{coefficients: []float64{0, 1, 0, -4}, want: []complex128{-2, 2}},
+		{coefficients: []float64{1, 0, 0}, want: []complex128{0, 0}},
+		{coefficients: []float64{1, -2, 5}, want: []complex128{1 - 2i, 1 + 2i}},
+	}
+
+	for _, tt := range tests {
+		got, bug := Roots(tt.coefficients...)
+		assert.Nilf(t, bug, "%v: Bug != nil", tt.coefficients)
+		assert.Lenf(t, got, len(tt.want), "%v", tt.coefficients)
+
+		for i := range tt.want {
+			assert.InDeltaf(t, real(tt.want[i]), real(got[i]), 1e-9, "%v", tt.coefficients)
+			assert.InDeltaf(t, imag(tt.want[i]), imag(got[i]), 1e-9, "%v", tt.coefficients)
+		}
+	}
+
+	_, bug := Roots(0, 5)
+	assert.True(t, ierr.As(bug, ierr.CtxNoRoots), "Bug != CtxNoRoots")
+}
+
+func TestLinSolve(t *testing.T) {
+	got, bug := LinSolve([][]float64{{2, 1}, {1, 3}}, []float64{3, 5})
+	assert.Nil(t, bug, "Bug != nil")
+	assert.InDeltaSlice(t, []float64{0.8, 1.4}, got, 1e-12)
+
+	got, bug = LinSolve([][]float64{{0, 2, 1}, {1, 1, 1}, {2, 0, -1}}, []float64{5, 6, -1})
+	assert.Nil(t, bug, "Bug != nil")
+	assert.InDeltaSlice(t, []float64{1.5, 0.5, 4}, got, 1e-12)
+
+	_, bug = LinSolve([][]float64{{1, 2}, {2, 4}}, []float64{1, 2})
+	assert.True(t, ierr.As(bug, ierr.CtxSingularMatrix), "Bug != CtxSingularMatrix")
+
+	_, bug = LinSolve([][]float64{{1, 2}, {3, 4}}, []float64{1})
+	assert.True(t, ierr.As(bug, ierr.CtxShapeMismatch), "Bug != CtxShapeMismatch")
+}
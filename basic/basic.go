@@ -1,28 +1,285 @@
 package basic
 
 import (
+	"math/big"
+	"time"
+
+	"github.com/brianlewyn/go-calculator/ierr"
 	"github.com/brianlewyn/go-calculator/internal/analyse"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/doubly"
 	"github.com/brianlewyn/go-calculator/internal/math"
 	"github.com/brianlewyn/go-calculator/internal/tokenize"
 )
 
+// Options represents the settings to solve an expression
+type Options struct {
+	// Compensated adds each additive run with the Kahan-Babuška-Neumaier summation,
+	// so long chains like 0.1+0.1+...+0.1 do not drift
+	Compensated bool
+
+	// Percent turns each '%' into a postfix percentage, where adding or subtracting
+	// one does it of the left operand but a negation, and the keyword mod keeps the modulo
+	//
+	//	200 + 10% = 220, 50% * 80 = 40, -10% = -0.1, 7 mod 4 = 3
+	Percent bool
+
+	// Complex solves the expression over the complex numbers, where i (or j)
+	// is the imaginary unit, so roots and powers of negative numbers are not NaN
+	//
+	//	√-4 = 2i, (1+2i)*(3-i) = 5+5i
+	Complex bool
+
+	// Units allows a unit after a number and checks the dimensions of each operation,
+	// where the keywords to and in convert a value to another unit
+	//
+	//	3 km / 20 min = 2.5 m/s, 5 ft to m = 1.524 m, 3 m + 2 s => error
+	Units bool
+
+	// Money allows a currency symbol before a number or a currency code after it
+	// with exact decimal amounts, where the keywords to and in convert money to another currency
+	//
+	//	$12.50 * 3 = 37.50 USD, 12 EUR to USD, $1 + 1 EUR => error without Rates
+	Money bool
+
+	// Rates are the exchange rates to add, subtract and convert money
+	// of different currencies, and it can be nil
+	Rates *Rates
+
+	// Dates allows date and time literals, and a unit of time after a number,
+	// where a date minus a date is a duration, and a date plus a duration is a date
+	//
+	//	2026-10-18 + 90 days = 2027-01-16, (17:45 - 09:10) * 5 = 1d18h55m
+	Dates bool
+
+	// Interval solves the expression over intervals, where each number is the tightest
+	// interval that contains it and each bound is rounded outward, so the result
+	// contains the exact one and its width is the worst-case rounding error
+	//
+	//	0.1 + 0.2 = [0.29999999999999993, 0.30000000000000004]
+	Interval bool
+}
+
+// Result represents the value of a solved expression, which is a real number
+// unless an option like Complex, Units, Money, Dates or Interval, or the operator ±,
+// allows other kinds of values, or the expression of an operand without a value
+// given to an Observer, like the arguments of a sum over a variable
+type Result struct {
+	value data.Token
+	label string
+}
+
 // Calculate solves a basic mathematical expression and returns the result and nil,
 // otherwise it returns a zero value and an error.
 func Calculate(expression string) (float64, error) {
-	list, err := tokenize.Tokenizer(expression)
+	return CalculateWith(expression, Options{})
+}
+
+// CalculateWith solves a basic mathematical expression with the given options
+// and returns the result and nil, otherwise it returns a zero value and an error,
+// which is also the case if the result is not a real number.
+func CalculateWith(expression string, opts Options) (float64, error) {
+	value, err := evaluate(expression, nil, opts)
 	if err != nil {
 		return 0, err
 	}
 
-	err = analyse.Analyser(list)
+	return math.Float(value)
+}
+
+// Evaluate solves a mathematical expression with the given options and returns the result and nil,
+// otherwise it returns a zero Result and an error.
+func Evaluate(expression string, opts Options) (Result, error) {
+	value, err := evaluate(expression, nil, opts)
 	if err != nil {
-		return 0, err
+		return Result{}, err
 	}
 
-	res64, err := math.Math(list)
+	return Result{value: value}, nil
+}
+
+// !Result Methods
+
+// Float returns the result as a real number, in its unit if it has one,
+// in seconds if it is a duration or its midpoint if it is an interval, and true,
+// otherwise returns false
+func (r Result) Float() (float64, bool) {
+	res64, err := math.Float(r.value)
+	return res64, err == nil
+}
+
+// Complex returns the result as a complex number and true, otherwise returns false
+func (r Result) Complex() (complex128, bool) {
+	switch value := r.value.(type) {
+	case data.Decimal:
+		return complex(value.Value(), 0), true
+	case data.Complex:
+		return value.Value(), true
+	}
+	return 0, false
+}
+
+// Time returns the result and true if it is a date or a time, otherwise returns false,
+// where a time without a date is on January 1 of the year 0
+func (r Result) Time() (time.Time, bool) {
+	value, ok := r.value.(data.Moment)
+	return value.Value(), ok
+}
+
+// Duration returns the result and true if it is a duration, otherwise returns false
+func (r Result) Duration() (time.Duration, bool) {
+	value, ok := r.value.(data.Span)
+	return value.Value(), ok
+}
+
+// Interval returns the bounds of the interval that contains the exact result and true,
+// where a real number is an interval of one number, otherwise returns false
+func (r Result) Interval() (lo, hi float64, ok bool) {
+	switch value := r.value.(type) {
+	case data.Interval:
+		return value.Lo(), value.Hi(), true
+	case data.Decimal:
+		return value.Value(), value.Value(), true
+	}
+	return 0, 0, false
+}
+
+// Uncertainty returns the mean and the standard uncertainty of the result and true,
+// where a real number has no uncertainty, otherwise returns false
+func (r Result) Uncertainty() (mean, sigma float64, ok bool) {
+	switch value := r.value.(type) {
+	case data.Uncertain:
+		return value.Value(), math.Uncertainty(value), true
+	case data.Decimal:
+		return value.Value(), 0, true
+	}
+	return 0, 0, false
+}
+
+// Vector returns the result as real numbers and true if it is a vector, otherwise returns false
+func (r Result) Vector() ([]float64, bool) {
+	value, ok := r.value.(data.Array)
+	if !ok {
+		return nil, false
+	}
+
+	xs := make([]float64, len(value.Value()))
+	for i, e := range value.Value() {
+		x, err := math.Float(e)
+		if err != nil {
+			return nil, false
+		}
+		xs[i] = x
+	}
+	return xs, true
+}
+
+// Matrix returns the result as rows of real numbers and true if it is a matrix, otherwise returns false
+func (r Result) Matrix() ([][]float64, bool) {
+	value, ok := r.value.(data.Array)
+	if !ok {
+		return nil, false
+	}
+
+	rows := make([][]float64, len(value.Value()))
+	for i, e := range value.Value() {
+		row, ok := Result{value: e}.Vector()
+		if !ok {
+			return nil, false
+		}
+		rows[i] = row
+	}
+	return rows, true
+}
+
+// Amount returns the exact amount of the result and true if it is money, otherwise returns false
+func (r Result) Amount() (*big.Rat, bool) {
+	value, ok := r.value.(data.Money)
+	if !ok {
+		return nil, false
+	}
+	return new(big.Rat).Set(value.Value()), true
+}
+
+// Unit returns the unit or the currency code of the result,
+// which is empty if it is dimensionless
+//
+//	km, m/s, USD
+func (r Result) Unit() string {
+	switch value := r.value.(type) {
+	case data.Quantity:
+		if unit := value.Unit(); unit.Name != "" {
+			return unit.Name
+		}
+		return value.Unit().Dim.String()
+
+	case data.Money:
+		return value.Currency()
+	}
+	return ""
+}
+
+// String returns the result formatted
+//
+//	1.5, 2i, 1-2i, 2.5 m/s, 12.50 USD, 2026-10-18, 8h35m, [1, 2], [0.09999999999999999, 0.1], 12.3 ± 0.4
+func (r Result) String() string {
+	if r.value == nil {
+		return r.label
+	}
+	return math.Format(r.value)
+}
+
+// !Tool Functions
+
+// evaluate solves a mathematical expression whose names are resolved by env
+// and returns the value and nil, otherwise it returns nil and an error.
+func evaluate(expression string, env math.Env, opts Options) (value data.Token, err error) {
+	defer recoverPanic(expression, &err)
+
+	list, err := analysed(expression, opts.tokenize())
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	return math.Evaluate(list, env, opts.math())
+}
+
+// analysed returns a mathematical expression tokenized with the given options and analysed, and nil,
+// otherwise it returns nil and an error.
+func analysed(expression string, opts tokenize.Options) (list *doubly.Doubly, err error) {
+	defer recoverPanic(expression, &err)
+
+	list, err = tokenize.TokenizerWith(expression, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	return res64, nil
+	err = analyse.Analyser(list)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// recoverPanic turns a panic while solving the expression into an internal error,
+// so a bug is returned with the expression that causes it instead of crashing the program
+func recoverPanic(expression string, err *error) {
+	if r := recover(); r != nil {
+		*err = ierr.Panic(expression, r)
+	}
+}
+
+// tokenize returns the options of the tokenize package
+func (o Options) tokenize() tokenize.Options {
+	return tokenize.Options{Percent: o.Percent, Complex: o.Complex, Units: o.Units, Money: o.Money, Dates: o.Dates}
+}
+
+// math returns the options of the math package
+func (o Options) math() math.Options {
+	opts := math.Options{Compensated: o.Compensated, Complex: o.Complex, Interval: o.Interval}
+	if o.Rates != nil {
+		opts.Rates = o.Rates
+	}
+	return opts
 }
@@ -2,7 +2,9 @@ package basic
 
 import (
 	"math"
+	"math/cmplx"
 	"testing"
+	"time"
 
 	"github.com/brianlewyn/go-calculator/ierr"
 	"github.com/stretchr/testify/assert"
@@ -75,6 +77,11 @@ func TestCalculate(t *testing.T) {
 			expr: "2^2",
 			want: math.Pow(2, 2),
 		},
+		{
+			name: "Power of a Root Square",
+			expr: "2^√√16",
+			want: math.Pow(2, math.Sqrt(math.Sqrt(16))),
+		},
 		{
 			name: "Power: as in NaN but without parentheses",
 			expr: "-2^(1/2)",
@@ -100,6 +107,36 @@ func TestCalculate(t *testing.T) {
 			expr: "(0.5 + 4.5 - 1) * 10 * √(6-2) / 4^2",
 			want: 5,
 		},
+		{
+			name: "Aggregate functions",
+			expr: "sum(1, 2, 3, 4) / median(4, -1, 3, 1) + mode(1, 2, 2)",
+			want: 7,
+		},
+		{
+			name: "Aggregate function without its arguments",
+			expr: "stddev(3)",
+			as:   ierr.CtxArgumentCount,
+		},
+		{
+			name: "Financial function without convergence",
+			expr: "rate(10, 100, 1000)",
+			as:   ierr.CtxNoConvergence,
+		},
+		{
+			name: "Series and integrals",
+			expr: "Σ(k^2, k, 1, 10) + ∏(k, k, 1, 5) + integrate(2*x, x, 0, 3)",
+			want: 514,
+		},
+		{
+			name: "Series with too many terms",
+			expr: "sum(1/k, k, 1, 10^9)",
+			as:   ierr.CtxIterationLimit,
+		},
+		{
+			name: "Integral without convergence",
+			expr: "integrate(sin(1/x), x, 0, 1)",
+			as:   ierr.CtxNoConvergence,
+		},
 		{
 			name: "Decimal precision",
 			expr: "1.12345 * 1.12345",
@@ -125,6 +162,426 @@ func TestCalculate(t *testing.T) {
 	}
 }
 
+func TestCalculateWith(t *testing.T) {
+	t.Run("Compensated summation", func(t *testing.T) {
+		expr := "100000000000000000000 + 1 - 100000000000000000000 + 0.1 + 0.2"
+
+		got, bug := CalculateWith(expr, Options{Compensated: true})
+		assert.Nil(t, bug, "Bug != nil")
+		assert.Equal(t, 1.3, got)
+
+		got, bug = Calculate(expr)
+		assert.Nil(t, bug, "Bug != nil")
+		assert.NotEqual(t, 1.3, got)
+	})
+
+	t.Run("Percent mode", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want float64
+			as   ierr.KindOf
+		}{
+			{expr: "200 + 10%", want: 220},
+			{expr: "200 - 10%", want: 180},
+			{expr: "50% * 80", want: 40},
+			{expr: "80 * 50%", want: 40},
+			{expr: "100 + 50 + 10%", want: 165},
+			{expr: "(100 + 100)% * 3", want: 6},
+			{expr: "200 + (10%)", want: 200.1},
+			{expr: "max(10%, 5%) + 1", want: 1.1},
+			{expr: "7 mod 4 + 10%", want: 3.3},
+			{expr: "-10%", want: -0.1},
+			{expr: "(-10%)", want: -0.1},
+			{expr: "sum(-10%, 1)", want: 0.9},
+			{expr: "-10% + 1", want: 0.9},
+			{expr: "0 - 10% + 1", want: 1},
+			{expr: "7 % 4", as: ierr.CtxPercentInfix},
+			{expr: "%5", as: ierr.CtxKindStart},
+		}
+
+		for _, tt := range tests {
+			for _, opts := range []Options{{Percent: true}, {Percent: true, Compensated: true}} {
+				got, bug := CalculateWith(tt.expr, opts)
+				if tt.as != "" {
+					assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v", tt.expr, tt.as)
+					continue
+				}
+
+				assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+				assert.InDeltaf(t, tt.want, got, 1e-12, "%s: got: %v, want: %v (%+v)", tt.expr, got, tt.want, opts)
+			}
+		}
+
+		got, bug := CalculateWith("(200 + 10%) - 10%", Options{Percent: true, Compensated: true})
+		assert.Nil(t, bug, "Bug != nil")
+		assert.InDelta(t, 198, got, 1e-12)
+	})
+
+	t.Run("Modulo without the percent mode", func(t *testing.T) {
+		got, bug := Calculate("7 mod 4 + 7 % 4")
+		assert.Nil(t, bug, "Bug != nil")
+		assert.Equal(t, 6.0, got)
+
+		_, bug = Calculate("200 + 10%")
+		assert.True(t, ierr.As(bug, ierr.CtxPercentOff), "Bug != CtxPercentOff")
+
+		_, bug = Calculate("10% * 2")
+		assert.True(t, ierr.As(bug, ierr.CtxPercentOff), "Bug != CtxPercentOff")
+	})
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Run("Complex mode", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want complex128
+			str  string
+			as   ierr.KindOf
+		}{
+			{expr: "√-4", want: 2i, str: "2i"},
+			{expr: "√√(-16)", want: cmplx.Sqrt(4i), str: "1.4142135623730951+1.4142135623730951i"},
+			{expr: "(-2)^(1/2)", want: cmplx.Pow(-2, 0.5)},
+			{expr: "(1+2i)*(3-i)", want: 5 + 5i, str: "5+5i"},
+			{expr: "i^2", want: -1, str: "-1"},
+			{expr: "2j^-2 / 4", want: -0.0625, str: "-0.0625"},
+			{expr: "1 - i/2", want: 1 - 0.5i, str: "1-0.5i"},
+			{expr: "exp(i*π) + 1", want: cmplx.Exp(1i*math.Pi) + 1},
+			{expr: "ln(-1)", want: complex(0, math.Pi), str: "3.141592653589793i"},
+			{expr: "abs(3+4i) + arg(i) + re(2-i) + im(2-i)", want: 6 + math.Pi/2},
+			{expr: "conj(1+2i)", want: 1 - 2i, str: "1-2i"},
+			{expr: "sqrt(-9) + acos(2)", want: 3i + cmplx.Acos(2)},
+			{expr: "5i mod 2", as: ierr.CtxValueOperation},
+			{expr: "sum(1, i)", as: ierr.CtxNotReal},
+		}
+
+		for _, tt := range tests {
+			got, bug := Evaluate(tt.expr, Options{Complex: true})
+			if tt.as != "" {
+				assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v", tt.expr, tt.as)
+				continue
+			}
+
+			assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+
+			z, ok := got.Complex()
+			assert.Truef(t, ok, "%s: not a complex number", tt.expr)
+			assert.InDeltaf(t, real(tt.want), real(z), 1e-15, "%s: got: %v, want: %v", tt.expr, z, tt.want)
+			assert.InDeltaf(t, imag(tt.want), imag(z), 1e-15, "%s: got: %v, want: %v", tt.expr, z, tt.want)
+
+			if tt.str != "" {
+				assert.Equalf(t, tt.str, got.String(), "%s", tt.expr)
+			}
+		}
+	})
+
+	t.Run("Complex result without a real part", func(t *testing.T) {
+		_, bug := CalculateWith("√-4", Options{Complex: true})
+		assert.True(t, ierr.As(bug, ierr.CtxNotReal), "Bug != CtxNotReal")
+
+		got, bug := CalculateWith("(2i)^2", Options{Complex: true})
+		assert.Nil(t, bug, "Bug != nil")
+		assert.Equal(t, -4.0, got)
+	})
+
+	t.Run("Units mode", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want float64
+			unit string
+			as   ierr.KindOf
+		}{
+			{expr: "3 km / 20 min", want: 2.5, unit: "m/s"},
+			{expr: "3 km / 20 min to km/h", want: 9, unit: "km/h"},
+			{expr: "5 ft to m", want: 1.524, unit: "m"},
+			{expr: "5 ft in inch", want: 60, unit: "inch"},
+			{expr: "5 ft in in", want: 60, unit: "in"},
+			{expr: "1 in + 1 inch in cm", want: 5.08, unit: "cm"},
+			{expr: "5 ft to m + 1 m", want: 2.524, unit: "m"},
+			{expr: "2 m ^ 2 + 2 m^2", want: 4, unit: "m^2"},
+			{expr: "1 h + 30 min", want: 1.5, unit: "h"},
+			{expr: "2 kg * 9.8 m/s^2 to N", want: 19.6, unit: "N"},
+			{expr: "√(9 m^2) - 50 cm", want: 2.5, unit: "m"},
+			{expr: "-3 m * 2", want: -6, unit: "m"},
+			{expr: "3 km / 1500 m", want: 2},
+			{expr: "3 m + 2 s", as: ierr.CtxDimensionMismatch},
+			{expr: "5 kg to m", as: ierr.CtxDimensionMismatch},
+			{expr: "sum(1 m, 2)", as: ierr.CtxDimensionMismatch},
+			{expr: "√(9 m)", as: ierr.CtxValueOperation},
+			{expr: "5 m to xyz", as: ierr.CtxUnitUnknown},
+			{expr: "5 m to", as: ierr.CtxKindEnd},
+		}
+
+		for _, tt := range tests {
+			got, bug := Evaluate(tt.expr, Options{Units: true})
+			if tt.as != "" {
+				assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v", tt.expr, tt.as)
+				continue
+			}
+
+			assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+
+			f, ok := got.Float()
+			assert.Truef(t, ok, "%s: not a real number", tt.expr)
+			assert.InDeltaf(t, tt.want, f, 1e-12, "%s: got: %v, want: %v", tt.expr, f, tt.want)
+			assert.Equalf(t, tt.unit, got.Unit(), "%s", tt.expr)
+		}
+
+		got, bug := Evaluate("3 km / 20 min", Options{Units: true})
+		assert.Nil(t, bug, "Bug != nil")
+		assert.Equal(t, "2.5 m/s", got.String())
+
+		_, bug = Calculate("5 m")
+		assert.True(t, ierr.As(bug, ierr.CtxKindNotTogether), "Bug != CtxKindNotTogether")
+	})
+
+	t.Run("Arrays", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want string
+			as   ierr.KindOf
+		}{
+			{expr: "[1, 2, 3] · [4, 5, 6]", want: "32"},
+			{expr: "det([[1, 2], [3, 4]])", want: "-2"},
+			{expr: "[[1, 2], [3, 4]] · [[5, 6], [7, 8]]", want: "[[19, 22], [43, 50]]"},
+			{expr: "[[1, 2], [3, 4]] · [1, 1]", want: "[3, 7]"},
+			{expr: "[[1, 2], [3, 4]] + [10, 20]", want: "[[11, 22], [13, 24]]"},
+			{expr: "-[1, 2] * 2^2", want: "[-4, -8]"},
+			{expr: "√[4, 9] / 2", want: "[1, 1.5]"},
+			{expr: "cross([1, 0, 0], [0, 1, 0])", want: "[0, 0, 1]"},
+			{expr: "transpose([[1, 2, 3], [4, 5, 6]])", want: "[[1, 4], [2, 5], [3, 6]]"},
+			{expr: "inv([[2, 0], [0, 4]])", want: "[[0.5, 0], [0, 0.25]]"},
+			{expr: "norm([3, 4])", want: "5"},
+			{expr: "sum([1, 2], 3)", want: "6"},
+			{expr: "[1, 2] + [1, 2, 3]", as: ierr.CtxShapeMismatch},
+			{expr: "[[1, 2], [3]]", as: ierr.CtxShapeMismatch},
+			{expr: "[[1, 2], [3, 4]] · [1, 2, 3]", as: ierr.CtxShapeMismatch},
+			{expr: "det([[1, 2, 3], [4, 5, 6]])", as: ierr.CtxShapeMismatch},
+			{expr: "cross([1, 2], [3, 4])", as: ierr.CtxShapeMismatch},
+			{expr: "[1, 2)", as: ierr.CtxKindNotTogether},
+		}
+
+		for _, tt := range tests {
+			got, bug := Evaluate(tt.expr, Options{})
+			if tt.as != "" {
+				assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v", tt.expr, tt.as)
+				continue
+			}
+
+			assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+			assert.Equalf(t, tt.want, got.String(), "%s", tt.expr)
+		}
+
+		_, bug := Evaluate("inv([[1, 2], [2, 4]])", Options{})
+		assert.True(t, ierr.As(bug, ierr.CtxSingularMatrix), "Bug != CtxSingularMatrix")
+
+		got, bug := Evaluate("[[1, 2], [3, 4]] * 10", Options{})
+		assert.Nil(t, bug, "Bug != nil")
+
+		matrix, ok := got.Matrix()
+		assert.True(t, ok, "not a matrix")
+		assert.Equal(t, [][]float64{{10, 20}, {30, 40}}, matrix)
+
+		_, ok = got.Vector()
+		assert.False(t, ok, "a vector")
+	})
+
+	t.Run("Interval mode", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want string
+			as   ierr.KindOf
+			is   error
+		}{
+			{expr: "0.5 * 4", want: "[2, 2]"},
+			{expr: "0.1", want: "[0.09999999999999999, 0.1]"},
+			{expr: "0.1 + 0.2", want: "[0.29999999999999993, 0.30000000000000004]"},
+			{expr: "1 / 3", want: "[0.3333333333333333, 0.33333333333333337]"},
+			{expr: "√2", want: "[1.414213562373095, 1.4142135623730951]"},
+			{expr: "(-1.5)^2 - 2^10", want: "[-1021.75, -1021.75]"},
+			{expr: "7.5 % 2", want: "[1.5, 1.5]"},
+			{expr: "π", want: "[3.141592653589793, 3.1415926535897936]"},
+			{expr: "1 / (0.1 - 0.1)", is: ierr.IsInf},
+			{expr: "3 % 0", is: ierr.IsNaN},
+			{expr: "sum(1, 2)", as: ierr.CtxValueOperation},
+		}
+
+		for _, tt := range tests {
+			got, bug := Evaluate(tt.expr, Options{Interval: true})
+			if tt.as != "" {
+				assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v", tt.expr, tt.as)
+				continue
+			}
+			if tt.is != nil {
+				assert.ErrorIsf(t, bug, tt.is, "%s", tt.expr)
+				continue
+			}
+
+			assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+			assert.Equalf(t, tt.want, got.String(), "%s", tt.expr)
+		}
+
+		got, bug := Evaluate("sin(π/6) * 2", Options{Interval: true})
+		assert.Nil(t, bug, "Bug != nil")
+
+		lo, hi, ok := got.Interval()
+		assert.True(t, ok, "not an interval")
+		assert.True(t, lo <= 1 && 1 <= hi, "1 is not inside [%v, %v]", lo, hi)
+		assert.Less(t, hi-lo, 1e-15)
+
+		got, bug = Evaluate("200 + 10%", Options{Interval: true, Percent: true})
+		assert.Nil(t, bug, "Bug != nil")
+		assert.Equal(t, "[219.99999999999997, 220.00000000000003]", got.String())
+	})
+
+	t.Run("Uncertainty", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want string
+			as   ierr.KindOf
+		}{
+			{expr: "12.34 ± 0.4", want: "12.3 ± 0.4"},
+			{expr: "2 * 9.81 ± 0.02", want: "19.62 ± 0.04"},
+			{expr: "(2 ± 0.1) * (3 ± 0.2)", want: "6.0 ± 0.5"},
+			{expr: "√(4 ± 0.4)", want: "2.00 ± 0.10"},
+			{expr: "sin(0 ± 0.1)", want: "0.00 ± 0.10"},
+			{expr: "(9.81 ± 0.02) - (9.81 ± 0.02)", want: "0.00 ± 0.03"},
+			{expr: "1 ± -1", as: ierr.CtxValueOperation},
+			{expr: "sum(1 ± 1, 2)", as: ierr.CtxValueOperation},
+		}
+
+		for _, tt := range tests {
+			got, bug := Evaluate(tt.expr, Options{})
+			if tt.as != "" {
+				assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v", tt.expr, tt.as)
+				continue
+			}
+
+			assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+			assert.Equalf(t, tt.want, got.String(), "%s", tt.expr)
+		}
+
+		got, bug := Evaluate("(3 ± 0.3) / 2", Options{})
+		assert.Nil(t, bug, "Bug != nil")
+
+		mean, sigma, ok := got.Uncertainty()
+		assert.True(t, ok, "not an uncertain value")
+		assert.InDelta(t, 1.5, mean, 1e-12)
+		assert.InDelta(t, 0.15, sigma, 1e-12)
+
+		got, bug = Evaluate("(200 ± 10) + 10%", Options{Percent: true})
+		assert.Nil(t, bug, "Bug != nil")
+		assert.Equal(t, "220 ± 11", got.String())
+	})
+
+	t.Run("Dates mode", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want string
+			as   ierr.KindOf
+		}{
+			{expr: "2026-10-18 + 90 days", want: "2027-01-16"},
+			{expr: "(17:45 - 09:10) * 5", want: "1d18h55m"},
+			{expr: "2026-10-18 - 2026-01-01", want: "290d"},
+			{expr: "2026-10-18 + 3 h", want: "2026-10-18T03:00"},
+			{expr: "2026-10-18T10:00 - 2 weeks", want: "2026-10-04T10:00"},
+			{expr: "23:00 + 2 hours", want: "01:00"},
+			{expr: "-3 days + 1 week", want: "4d"},
+			{expr: "1 h / 30 min", want: "2"},
+			{expr: "1.5 s", want: "1.5s"},
+			{expr: "2026-10-18 + 2026-10-18", as: ierr.CtxKindNotOperable},
+			{expr: "17:45 - 2026-10-18", as: ierr.CtxKindNotOperable},
+			{expr: "3 days + 1", as: ierr.CtxKindNotOperable},
+			{expr: "2026-13-01", as: ierr.CtxDateMisspelled},
+			{expr: "sum(1 day)", as: ierr.CtxValueOperation},
+		}
+
+		for _, tt := range tests {
+			got, bug := Evaluate(tt.expr, Options{Dates: true})
+			if tt.as != "" {
+				assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v", tt.expr, tt.as)
+				continue
+			}
+
+			assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+			assert.Equalf(t, tt.want, got.String(), "%s", tt.expr)
+		}
+
+		got, bug := Evaluate("17:45 - 09:10", Options{Dates: true})
+		assert.Nil(t, bug, "Bug != nil")
+
+		d, ok := got.Duration()
+		assert.True(t, ok, "not a duration")
+		assert.Equal(t, 8*time.Hour+35*time.Minute, d)
+
+		got, bug = Evaluate("2026-10-18 + 1 day", Options{Dates: true})
+		assert.Nil(t, bug, "Bug != nil")
+
+		date, ok := got.Time()
+		assert.True(t, ok, "not a date")
+		assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), date)
+	})
+
+	t.Run("Money mode", func(t *testing.T) {
+		rates := NewRates()
+		assert.Nil(t, rates.Set("USD", "EUR", "0.92"), "Bug != nil")
+		assert.Nil(t, rates.Set("USD", "MXN", "17.05"), "Bug != nil")
+
+		tests := []struct {
+			expr string
+			want string
+			as   ierr.KindOf
+		}{
+			{expr: "$12.50 * 3", want: "37.50 USD"},
+			{expr: "$0.10 + $0.20 - $0.30", want: "0.00 USD"},
+			{expr: "12 EUR to USD", want: "13.04 USD"},
+			{expr: "10 EUR in MXN", want: "185.33 MXN"},
+			{expr: "$1 + 0.92 EUR", want: "2.00 USD"},
+			{expr: "$10 / 4", want: "2.50 USD"},
+			{expr: "$10 / $4", want: "2.5"},
+			{expr: "-€5 + 7 EUR", want: "2.00 EUR"},
+			{expr: "12 GBP to USD", as: ierr.CtxRateUnknown},
+			{expr: "$10 * $4", as: ierr.CtxValueOperation},
+			{expr: "$5 + 1", as: ierr.CtxValueOperation},
+			{expr: "sum($1, $2)", as: ierr.CtxValueOperation},
+		}
+
+		for _, tt := range tests {
+			got, bug := Evaluate(tt.expr, Options{Money: true, Rates: rates})
+			if tt.as != "" {
+				assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v", tt.expr, tt.as)
+				continue
+			}
+
+			assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+			assert.Equalf(t, tt.want, got.String(), "%s", tt.expr)
+		}
+
+		got, bug := Evaluate("$0.10 + $0.20", Options{Money: true})
+		assert.Nil(t, bug, "Bug != nil")
+
+		amount, ok := got.Amount()
+		assert.True(t, ok, "not money")
+		assert.Equal(t, "3/10", amount.String())
+
+		_, bug = Evaluate("$1 + 1 EUR", Options{Money: true})
+		assert.True(t, ierr.As(bug, ierr.CtxRateUnknown), "Bug != CtxRateUnknown")
+
+		res64, bug := CalculateWith("$10 / 3", Options{Money: true})
+		assert.Nil(t, bug, "Bug != nil")
+		assert.Equal(t, 10.0/3, res64)
+	})
+
+	t.Run("Real result", func(t *testing.T) {
+		got, bug := Evaluate("√2 * 2", Options{})
+		assert.Nil(t, bug, "Bug != nil")
+		assert.Equal(t, "2.8284271247461903", got.String())
+
+		f, ok := got.Float()
+		assert.True(t, ok, "not a real number")
+		assert.Equal(t, math.Sqrt(2)*2, f)
+	})
+}
+
 // go test -bench=BenchmarkCalculate -benchmem -count=10 -benchtime=100x >> bench.txt
 func BenchmarkCalculate(b *testing.B) {
 	for n := 0; n < b.N; n++ {
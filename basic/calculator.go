@@ -0,0 +1,344 @@
+package basic
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/math"
+)
+
+// DefaultMaxDepth is the default limit of nested calls to user-defined functions
+const DefaultMaxDepth = 256
+
+// !Data
+
+// Function represents a user-defined function
+type Function struct {
+	Name   string
+	Params []string
+	Body   string
+}
+
+// Calculator solves scripts of mathematical expressions and keeps
+// the variables and functions defined by them.
+//
+// The zero value is ready to use.
+type Calculator struct {
+	// MaxDepth is the limit of nested calls to user-defined functions,
+	// if it is zero or less DefaultMaxDepth is used
+	MaxDepth int
+
+	// Options are the settings to solve each statement
+	Options Options
+
+	// Observer is notified of each token, operation and error, and it can be nil
+	Observer Observer
+
+	vars  map[string]data.Token
+	funcs map[string]Function
+}
+
+// scope resolves the names of an expression, where the parameters
+// of the function being called shadow the variables of the calculator
+type scope struct {
+	calc   *Calculator
+	params map[string]data.Token
+	depth  int
+}
+
+// !Functions to create an instance with New
+
+// New returns a new instance of Calculator
+func New() *Calculator {
+	return &Calculator{MaxDepth: DefaultMaxDepth}
+}
+
+// !Calculator Methods
+
+// Run solves a script of statements separated by ';' and returns the result of
+// the last expression or variable definition and nil, otherwise it returns a zero value and an error,
+// which is also the case if the result is not a real number.
+//
+//	a = 2; f(x) = x^2 + a; f(3) + f(4)
+//
+// The definitions made before an error are kept.
+func (c *Calculator) Run(script string) (float64, error) {
+	res, err := c.Evaluate(script)
+	if err != nil || res.value == nil {
+		return 0, err
+	}
+
+	return math.Float(res.value)
+}
+
+// Evaluate solves a script like Run and returns the result of the last expression
+// or variable definition and nil, otherwise it returns a zero Result and an error.
+func (c *Calculator) Evaluate(script string) (Result, error) {
+	res, err := c.evaluateScript(script)
+	if err != nil && c.Observer != nil {
+		c.Observer.OnError(err)
+	}
+	return res, err
+}
+
+// Functions returns the user-defined functions sorted by name
+func (c *Calculator) Functions() []Function {
+	funcs := make([]Function, 0, len(c.funcs))
+	for _, fn := range c.funcs {
+		funcs = append(funcs, fn)
+	}
+
+	sort.Slice(funcs, func(i, j int) bool {
+		return funcs[i].Name < funcs[j].Name
+	})
+
+	return funcs
+}
+
+// Function returns the user-defined function with the given name and true,
+// otherwise returns false
+func (c *Calculator) Function(name string) (Function, bool) {
+	fn, ok := c.funcs[name]
+	return fn, ok
+}
+
+// Variable returns the value of the variable with the given name and true,
+// otherwise returns false
+func (c *Calculator) Variable(name string) (Result, bool) {
+	value, ok := c.vars[name]
+	return Result{value: value}, ok
+}
+
+// String returns the definition of the function
+func (f Function) String() string {
+	return fmt.Sprintf("%s(%s) = %s", f.Name, strings.Join(f.Params, ", "), f.Body)
+}
+
+// !Scope Methods
+
+// Var returns the value of a parameter or a variable and true, otherwise returns false
+func (s scope) Var(name string) (data.Token, bool) {
+	if value, ok := s.params[name]; ok {
+		return value, true
+	}
+
+	value, ok := s.calc.vars[name]
+	return value, ok
+}
+
+// Func returns a user-defined function as a math.Func and true, otherwise returns false
+func (s scope) Func(name string) (math.Func, bool) {
+	fn, ok := s.calc.funcs[name]
+	if !ok {
+		return nil, false
+	}
+
+	return func(args []data.Token) (data.Token, error) {
+		return s.call(fn, args)
+	}, true
+}
+
+// call returns the result of calling a user-defined function and nil,
+// otherwise returns nil and an error
+func (s scope) call(fn Function, args []data.Token) (data.Token, error) {
+	if len(args) != len(fn.Params) {
+		return nil, ierr.ArgumentCount(fn.Name, len(args))
+	}
+
+	if s.depth >= s.calc.maxDepth() {
+		return nil, ierr.DepthLimit(fn.Name)
+	}
+
+	params := make(map[string]data.Token, len(args))
+	for i, param := range fn.Params {
+		params[param] = args[i]
+	}
+
+	return s.calc.evaluate(fn.Body, scope{calc: s.calc, params: params, depth: s.depth + 1})
+}
+
+// !Tool Methods
+
+// evaluateScript solves each statement of a script like Evaluate
+func (c *Calculator) evaluateScript(script string) (Result, error) {
+	var res Result
+
+	for _, statement := range strings.Split(script, ";") {
+		if strings.TrimSpace(statement) == "" {
+			continue
+		}
+
+		head, body, ok := strings.Cut(statement, "=")
+		if !ok {
+			value, err := c.evaluate(statement, c.global())
+			if err != nil {
+				return Result{}, err
+			}
+			res = Result{value: value}
+			continue
+		}
+
+		name, params, isFunc, err := parseHead(head)
+		if err != nil {
+			return Result{}, err
+		}
+
+		if isFunc {
+			err = c.defineFunction(name, params, body)
+			if err != nil {
+				return Result{}, err
+			}
+			continue
+		}
+
+		if c.isReserved(name) {
+			return Result{}, ierr.NameReserved(name)
+		}
+
+		value, err := c.evaluate(body, c.global())
+		if err != nil {
+			return Result{}, err
+		}
+
+		c.defineVariable(name, value)
+		res = Result{value: value}
+	}
+
+	return res, nil
+}
+
+// evaluate solves an expression whose names are resolved by env with the options of the calculator,
+// and notifies its observer if it has one
+func (c *Calculator) evaluate(expression string, env math.Env) (data.Token, error) {
+	if c.Observer == nil {
+		return evaluate(expression, env, c.Options)
+	}
+	return observe(expression, env, c.Options, c.Observer)
+}
+
+// global returns the scope of the statements of a script
+func (c *Calculator) global() scope {
+	return scope{calc: c}
+}
+
+// maxDepth returns the limit of nested calls
+func (c *Calculator) maxDepth() int {
+	if c.MaxDepth <= 0 {
+		return DefaultMaxDepth
+	}
+	return c.MaxDepth
+}
+
+// isReserved returns true if name can't be a variable or a parameter
+func (c *Calculator) isReserved(name string) bool {
+	if c.Options.Units && (data.IsUnit(name) || data.IsConversion(name)) {
+		return true
+	}
+	if c.Options.Money && data.IsConversion(name) {
+		return true
+	}
+	if c.Options.Dates && data.IsDuration(name) {
+		return true
+	}
+	return data.IsKeyword(name) || c.Options.Complex && data.IsImaginary(name)
+}
+
+// defineVariable keeps the variable with its value
+func (c *Calculator) defineVariable(name string, value data.Token) {
+	if c.vars == nil {
+		c.vars = make(map[string]data.Token)
+	}
+	c.vars[name] = value
+}
+
+// defineFunction keeps the function if its name is not a builtin one or a keyword,
+// its parameters are not reserved and its body has a correct semantic, otherwise returns an error
+func (c *Calculator) defineFunction(name string, params []string, body string) error {
+	if data.IsBuiltin(name) || data.IsKeyword(name) {
+		return ierr.NameReserved(name)
+	}
+
+	for _, param := range params {
+		if c.isReserved(param) {
+			return ierr.NameReserved(param)
+		}
+	}
+
+	_, err := analysed(body, c.Options.tokenize())
+	if err != nil {
+		return err
+	}
+
+	if c.funcs == nil {
+		c.funcs = make(map[string]Function)
+	}
+
+	c.funcs[name] = Function{
+		Name:   name,
+		Params: params,
+		Body:   strings.TrimSpace(body),
+	}
+	return nil
+}
+
+// !Tool Functions
+
+// parseHead returns the name of a definition, and if it is a function, its parameters,
+// otherwise returns an error
+//
+//	a => a
+//	f(x, y) => f x y
+func parseHead(head string) (name string, params []string, isFunc bool, err error) {
+	head = strings.TrimSpace(head)
+
+	name, rest, isFunc := strings.Cut(head, string(data.Left))
+	name = strings.TrimSpace(name)
+
+	if !isName(name) {
+		return "", nil, false, ierr.NameMisspelled(head)
+	}
+
+	if !isFunc {
+		return name, nil, false, nil
+	}
+
+	rest, ok := strings.CutSuffix(rest, string(data.Right))
+	if !ok {
+		return "", nil, false, ierr.NameMisspelled(head)
+	}
+
+	seen := make(map[string]bool)
+	for _, param := range strings.Split(rest, string(data.Comma)) {
+		param = strings.TrimSpace(param)
+
+		if !isName(param) || seen[param] {
+			return "", nil, false, ierr.NameMisspelled(head)
+		}
+
+		seen[param] = true
+		params = append(params, param)
+	}
+
+	return name, params, true, nil
+}
+
+// isName returns true if s is a correct variable or function name
+func isName(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i, r := range s {
+		if i == 0 && !data.IsLetter(r) {
+			return false
+		}
+		if !data.IsWord(r) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,264 @@
+package basic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculatorRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   float64
+		as     ierr.KindOf
+	}{
+		{
+			name:   "Function definition & calls",
+			script: "f(x) = x^2 + 1; f(3) + f(4)",
+			want:   27,
+		},
+		{
+			name:   "Function with several parameters",
+			script: "hyp(a, b) = √(a^2 + b^2); hyp(3, -4) * 2",
+			want:   10,
+		},
+		{
+			name:   "Nested calls as arguments",
+			script: "f(x) = x * 2; g(x, y) = x - y; g(f(f(1)), -f(3))",
+			want:   10,
+		},
+		{
+			name:   "Variables",
+			script: "a = 2; b = a * 3; a + b",
+			want:   8,
+		},
+		{
+			name:   "Parameters shadow globals",
+			script: "x = 100; f(x) = x + 1; f(1) + x",
+			want:   102,
+		},
+		{
+			name:   "Globals are visible inside functions",
+			script: "k = 10; f(x) = x * k; f(2)",
+			want:   20,
+		},
+		{
+			name:   "Callee does not see caller's parameters",
+			script: "g(x) = x + y; f(y) = g(y); f(1)",
+			as:     ierr.CtxNameUnknown,
+		},
+		{
+			name:   "Recursion exceeds the depth limit",
+			script: "f(x) = f(x - 1) + 1; f(3)",
+			as:     ierr.CtxDepthLimit,
+		},
+		{
+			name:   "Wrong number of arguments",
+			script: "f(x) = x; f(1, 2)",
+			as:     ierr.CtxArgumentCount,
+		},
+		{
+			name:   "Unknown function",
+			script: "f(1)",
+			as:     ierr.CtxNameUnknown,
+		},
+		{
+			name:   "Misspelled definition",
+			script: "f(x, x) = x",
+			as:     ierr.CtxNameMisspelled,
+		},
+		{
+			name:   "Builtin function name",
+			script: "sum(x) = x",
+			as:     ierr.CtxNameReserved,
+		},
+		{
+			name:   "Keyword name",
+			script: "mod = 2",
+			as:     ierr.CtxNameReserved,
+		},
+		{
+			name:   "Builtin function with variables",
+			script: "a = 3; f(x) = max(x, a, 2); f(1) + f(5)",
+			want:   8,
+		},
+		{
+			name:   "Misspelled body",
+			script: "f(x) = x +",
+			as:     ierr.CtxKindEnd,
+		},
+		{
+			name:   "Comma outside of a function",
+			script: "(1, 2)",
+			as:     ierr.CtxKindOutside,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, bug := New().Run(tt.script)
+			if bug != nil {
+				t.Logf("Error:\n%s", bug)
+			}
+
+			if tt.as != "" {
+				assert.Truef(t, ierr.As(bug, tt.as), "Bug != %v", tt.as)
+				return
+			}
+
+			assert.Nil(t, bug, "Bug != nil")
+			assert.Equalf(t, tt.want, got, "got: %v, want: %v", got, tt.want)
+		})
+	}
+}
+
+func TestCalculatorMaxDepth(t *testing.T) {
+	c := New()
+	c.MaxDepth = 2
+
+	_, bug := c.Run("f(x) = x; g(x) = f(x); g(1)")
+	assert.Nil(t, bug, "Bug != nil")
+
+	_, bug = c.Run("h(x) = g(x); h(1)")
+	assert.True(t, ierr.As(bug, ierr.CtxDepthLimit), "Bug != CtxDepthLimit")
+}
+
+func TestCalculatorFunctions(t *testing.T) {
+	c := New()
+
+	_, bug := c.Run("g(x, y) = x*y; f(x) = x^2 + 1; a = 5")
+	assert.Nil(t, bug, "Bug != nil")
+
+	funcs := c.Functions()
+	if assert.Len(t, funcs, 2) {
+		assert.Equal(t, "f(x) = x^2 + 1", funcs[0].String())
+		assert.Equal(t, "g(x, y) = x*y", funcs[1].String())
+	}
+
+	fn, ok := c.Function("g")
+	assert.True(t, ok, "g is not defined")
+	assert.Equal(t, []string{"x", "y"}, fn.Params)
+
+	value, ok := c.Variable("a")
+	assert.True(t, ok, "a is not defined")
+	assert.Equal(t, "5", value.String())
+}
+
+func TestCalculatorUnits(t *testing.T) {
+	c := New()
+	c.Options.Units = true
+
+	res, bug := c.Evaluate("d = 42 km; t = 3.5 h; speed(x, y) = x / y to km/h; speed(d, t)")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, "12 km/h", res.String())
+
+	_, bug = c.Run("m = 2")
+	assert.True(t, ierr.As(bug, ierr.CtxNameReserved), "Bug != CtxNameReserved")
+
+	_, bug = c.Run("f(to) = to")
+	assert.True(t, ierr.As(bug, ierr.CtxNameReserved), "Bug != CtxNameReserved")
+}
+
+func TestCalculatorUncertainty(t *testing.T) {
+	c := New()
+
+	res, bug := c.Evaluate("x = 9.81 ± 0.02; y = 9.81 ± 0.02; x - x")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, "0 ± 0", res.String())
+
+	res, bug = c.Evaluate("x - y")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, "0.00 ± 0.03", res.String())
+
+	res, bug = c.Evaluate("f(a) = a / a; f(x)")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, "1 ± 0", res.String())
+}
+
+func TestCalculatorSeries(t *testing.T) {
+	c := New()
+
+	got, bug := c.Run("k = 100; n = 4; f(n) = Σ(k, k, 1, n); f(10) + k")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, 155.0, got)
+
+	got, bug = c.Run("g(x) = x^n; integrate(g(x), x, 0, 1)")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.InDelta(t, 0.2, got, 1e-12)
+
+	got, bug = c.Run("Σ(2, k, 1, 3) + sum(2, k, 1, 3)")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, 12.0, got)
+
+	got, bug = c.Run("n = 5; Σ(n, k, 1, 3)")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, 15.0, got)
+}
+
+// recorder is an Observer that writes down what it is notified of
+type recorder struct {
+	tokens     []string
+	analyses   []string
+	operations []string
+	errs       []error
+}
+
+func (r *recorder) OnToken(token string) {
+	r.tokens = append(r.tokens, token)
+}
+
+func (r *recorder) OnAnalyse(expression string, err error) {
+	if err != nil {
+		expression += ": " + err.Error()
+	}
+	r.analyses = append(r.analyses, expression)
+}
+
+func (r *recorder) OnOperation(op string, operands []Result, result Result) {
+	args := make([]string, len(operands))
+	for i, operand := range operands {
+		args[i] = operand.String()
+	}
+	r.operations = append(r.operations, op+"("+strings.Join(args, ", ")+") = "+result.String())
+}
+
+func (r *recorder) OnError(err error) {
+	r.errs = append(r.errs, err)
+}
+
+func TestCalculatorObserver(t *testing.T) {
+	r := &recorder{}
+	c := New()
+	c.Observer = r
+
+	got, bug := c.Run("f(x) = x^2; a = 3; f(a) + 1")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, 10.0, got)
+
+	assert.Equal(t, []string{"3", "f", "(", "a", ")", "+", "1", "x", "^", "2"}, r.tokens)
+	assert.Equal(t, []string{"3", "f(a) + 1", "x^2"}, r.analyses)
+	assert.Equal(t, []string{"^(3, 2) = 9", "f(3) = 9", "+(9, 1) = 10"}, r.operations)
+	assert.Empty(t, r.errs)
+
+	_, bug = c.Run("1 / 0 + 1")
+	assert.ErrorIs(t, bug, ierr.IsInf)
+	assert.Equal(t, []error{bug}, r.errs)
+
+	_, bug = c.Run("f(1, 2)")
+	assert.True(t, ierr.As(bug, ierr.CtxArgumentCount), "Bug != CtxArgumentCount")
+	assert.Len(t, r.errs, 2)
+
+	r.operations = nil
+	got, bug = c.Run("Σ(k^2, k, 1, 3)")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, 14.0, got)
+	assert.Equal(t, "sum(k^2, k, 1, 3) = 14", r.operations[len(r.operations)-1])
+
+	r.analyses = nil
+	_, bug = c.Run("1 +")
+	assert.NotNil(t, bug, "Bug == nil")
+	assert.Equal(t, []string{"1 +: " + bug.Error()}, r.analyses)
+	assert.Len(t, r.errs, 3)
+}
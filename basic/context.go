@@ -0,0 +1,127 @@
+package basic
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/analyse"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/math"
+	"github.com/brianlewyn/go-calculator/internal/tokenize"
+)
+
+// Limits represents the limits to solve an untrusted expression,
+// where a limit that is zero or less is not checked
+type Limits struct {
+	// MaxLength is the limit of characters of the expression
+	MaxLength int
+
+	// MaxTokens is the limit of tokens of the expression as it is written,
+	// like numbers, operators, parentheses, names and units
+	MaxTokens int
+
+	// MaxDepth is the limit of nested parentheses and brackets of the expression as it is written
+	MaxDepth int
+
+	// MaxOperations is the limit of operations done to solve the expression,
+	// like each operator, function call and term of a series
+	MaxOperations int
+
+	// Options are the settings to solve the expression
+	Options Options
+}
+
+// CalculateContext solves a basic mathematical expression within the given limits and returns the result and nil,
+// otherwise it returns a zero value and an error, which is also the case if the context is done
+// before the result, where the error wraps the one of the context.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	basic.CalculateContext(ctx, "sum(k^2, k, 1, 10)", basic.Limits{MaxOperations: 100})
+func CalculateContext(ctx context.Context, expression string, limits Limits) (res64 float64, err error) {
+	defer recoverPanic(expression, &err)
+
+	if limits.MaxLength > 0 && utf8.RuneCountInString(expression) > limits.MaxLength {
+		return 0, ierr.LengthLimit(limits.MaxLength)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, ierr.Canceled(err)
+	}
+
+	tokens := 0
+	tokenizeOpts := limits.Options.tokenize()
+	tokenizeOpts.Count = func(n int) {
+		tokens = n
+	}
+
+	list, err := tokenize.TokenizerWith(expression, tokenizeOpts)
+	if err != nil {
+		return 0, err
+	}
+
+	if limits.MaxTokens > 0 && tokens > limits.MaxTokens {
+		return 0, ierr.TokenLimit(limits.MaxTokens)
+	}
+
+	if limits.MaxDepth > 0 && depthOf(expression) > limits.MaxDepth {
+		return 0, ierr.NestingLimit(limits.MaxDepth)
+	}
+
+	err = analyse.Analyser(list)
+	if err != nil {
+		return 0, err
+	}
+
+	opts := limits.Options.math()
+	opts.Guard = limits.guard(ctx)
+
+	value, err := math.Evaluate(list, nil, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Float(value)
+}
+
+// !Limits Methods
+
+// guard returns the function called before each operation, which stops the calculation
+// if the context is done or there are more operations than the limit
+func (l Limits) guard(ctx context.Context) func() error {
+	operations := 0
+
+	return func() error {
+		if err := ctx.Err(); err != nil {
+			return ierr.Canceled(err)
+		}
+
+		operations++
+		if l.MaxOperations > 0 && operations > l.MaxOperations {
+			return ierr.OperationLimit(l.MaxOperations)
+		}
+		return nil
+	}
+}
+
+// !Tool Functions
+
+// depthOf returns the deepest nesting of parentheses and brackets of the expression as it is written,
+// without the ones that the tokenizer adds
+func depthOf(expression string) int {
+	depth, deepest := 0, 0
+
+	for _, r := range expression {
+		switch r {
+		case data.Left, data.LeftBracket:
+			depth++
+			if depth > deepest {
+				deepest = depth
+			}
+		case data.Right, data.RightBracket:
+			depth--
+		}
+	}
+	return deepest
+}
@@ -0,0 +1,69 @@
+package basic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateContext(t *testing.T) {
+	tests := []struct {
+		expr   string
+		limits Limits
+		want   float64
+		as     ierr.KindOf
+	}{
+		{expr: "1 + 2 * 3", want: 7},
+		{expr: "1 + 2 * 3", limits: Limits{MaxLength: 9, MaxTokens: 5, MaxDepth: 1, MaxOperations: 2}, want: 7},
+		{expr: "sum(k^2, k, 1, 10)", limits: Limits{MaxOperations: 100}, want: 385},
+		{expr: "1 + 2 * 3", limits: Limits{MaxLength: 8}, as: ierr.CtxLengthLimit},
+		{expr: "√√16", limits: Limits{MaxLength: 3}, as: ierr.CtxLengthLimit},
+		{expr: "1 + 2 * 3", limits: Limits{MaxTokens: 4}, as: ierr.CtxTokenLimit},
+		{expr: "((1 + 2))", limits: Limits{MaxDepth: 1}, as: ierr.CtxNestingLimit},
+		{expr: "max([1, (2)])", limits: Limits{MaxDepth: 2}, as: ierr.CtxNestingLimit},
+		{expr: "(2^-3)", limits: Limits{MaxTokens: 6, MaxDepth: 1}, want: 0.125},
+		{expr: "-1", limits: Limits{MaxTokens: 2}, want: -1},
+		{expr: "-1", limits: Limits{MaxTokens: 1}, as: ierr.CtxTokenLimit},
+		{expr: "(2i) * i", limits: Limits{MaxTokens: 6, MaxDepth: 1, Options: Options{Complex: true}}, want: -2},
+		{expr: "(3 km) / 1 m", limits: Limits{MaxTokens: 7, MaxDepth: 1, Options: Options{Units: true}}, want: 3000},
+		{expr: "[3 km, -2 m^2]", limits: Limits{MaxTokens: 9, Options: Options{Units: true}}, as: ierr.CtxTokenLimit},
+		{expr: "1 + 2 * 3", limits: Limits{MaxOperations: 1}, as: ierr.CtxOperationLimit},
+		{expr: "sum(k, k, 1, 1000)", limits: Limits{MaxOperations: 100}, as: ierr.CtxOperationLimit},
+		{expr: "integrate(x, x, 0, 1)", limits: Limits{MaxOperations: 10}, as: ierr.CtxOperationLimit},
+		{expr: "1 + i", limits: Limits{Options: Options{Complex: true}}, as: ierr.CtxNotReal},
+		{expr: "1 +", limits: Limits{MaxLength: 10}, as: ierr.CtxKindEnd},
+	}
+
+	for _, tt := range tests {
+		got, bug := CalculateContext(context.Background(), tt.expr, tt.limits)
+		if tt.as != "" {
+			assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v", tt.expr, tt.as)
+			continue
+		}
+
+		assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+		assert.InDeltaf(t, tt.want, got, 1e-12, "%s: got: %v, want: %v", tt.expr, got, tt.want)
+	}
+
+	t.Run("Canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, bug := CalculateContext(ctx, "1 + 2", Limits{})
+		assert.True(t, ierr.As(bug, ierr.CtxCanceled), "Bug != CtxCanceled")
+		assert.True(t, errors.Is(bug, context.Canceled), "Bug != context.Canceled")
+	})
+
+	t.Run("Deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, bug := CalculateContext(ctx, "sum(sum(j, j, 1, k), k, 1, 100000)", Limits{})
+		assert.True(t, ierr.As(bug, ierr.CtxCanceled), "Bug != CtxCanceled")
+		assert.True(t, errors.Is(bug, context.DeadlineExceeded), "Bug != context.DeadlineExceeded")
+	})
+}
@@ -0,0 +1,127 @@
+package basic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/math"
+)
+
+// Step represents one operation done while solving an expression, which is written as text
+// by its String method and as JSON by encoding/json
+type Step struct {
+	// Operation is the operator, like + or mod, or the name of the function
+	Operation string `json:"operation"`
+
+	// Operands are the values operated, or the arguments of the function
+	Operands []string `json:"operands"`
+
+	// Result is the value of the operation
+	Result string `json:"result"`
+
+	// Expression is the expression rewritten with the result of the operation
+	Expression string `json:"expression"`
+}
+
+// Explain solves a mathematical expression and returns each operation done, in the same order,
+// and nil, where the innermost parentheses are solved first, and inside each pair of them
+// the powers and roots, then the multiplications, divisions and modulos, and then the additions
+// and subtractions, otherwise it returns the operations done before an error and the error.
+//
+//	1 + 2 * 3 => 2 * 3 = 6 => 1 + 6
+//	             1 + 6 = 7 => 7
+func Explain(expression string) ([]Step, error) {
+	return ExplainWith(expression, Options{})
+}
+
+// ExplainWith solves a mathematical expression with the given options
+// and returns each operation done like Explain.
+func ExplainWith(expression string, opts Options) (steps []Step, err error) {
+	defer recoverPanic(expression, &err)
+
+	list, err := analysed(expression, opts.tokenize())
+	if err != nil {
+		return nil, err
+	}
+
+	mathOpts := opts.math()
+	mathOpts.Trace = func(step math.Step) {
+		steps = append(steps, newStep(step))
+	}
+
+	_, err = math.Evaluate(list, nil, mathOpts)
+	return steps, err
+}
+
+// !Step Methods
+
+// String returns the step as text, which is the operation with its result
+// and then the rewritten expression
+//
+//	2 * 3 = 6 => 1 + 6
+func (s Step) String() string {
+	return fmt.Sprintf("%s = %s => %s", s.operation(), s.Result, s.Expression)
+}
+
+// operation returns the operation of the step written as an expression
+func (s Step) operation() string {
+	switch {
+	case s.Operation == string(data.Root) && len(s.Operands) == 1:
+		return s.Operation + operand(s.Operands[0], true)
+
+	case s.Operation == string(data.Pow) && len(s.Operands) == 2:
+		return operand(s.Operands[0], true) + s.Operation + operand(s.Operands[1], false)
+
+	case s.Operation == string(data.Add) && len(s.Operands) > 2:
+		var b strings.Builder
+		b.WriteString(operand(s.Operands[0], true))
+
+		for _, x := range s.Operands[1:] {
+			if rest, ok := strings.CutPrefix(x, string(data.Sub)); ok {
+				b.WriteString(" - " + operand(rest, false))
+				continue
+			}
+			b.WriteString(" + " + operand(x, false))
+		}
+		return b.String()
+
+	case (!isName(s.Operation) || data.IsKeyword(s.Operation)) && len(s.Operands) == 2:
+		return operand(s.Operands[0], true) + " " + s.Operation + " " + operand(s.Operands[1], false)
+	}
+
+	return s.Operation + "(" + strings.Join(s.Operands, ", ") + ")"
+}
+
+// !Tool Functions
+
+// operand returns the operand of an operator wrapped in parentheses if it is compound,
+// like 1+2i, or if it is negative and it is not the first one
+func operand(s string, isFirst bool) string {
+	if len(s) < 2 || strings.HasPrefix(s, string(data.LeftBracket)) {
+		return s
+	}
+
+	if strings.ContainsAny(s[1:], " +-") || (!isFirst && strings.HasPrefix(s, string(data.Sub))) {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+// newStep returns the step of the math package as a Step
+func newStep(step math.Step) Step {
+	operands := step.Labels
+	if operands == nil {
+		operands = make([]string, len(step.Operands))
+		for i, operand := range step.Operands {
+			operands[i] = math.FormatToken(operand)
+		}
+	}
+
+	return Step{
+		Operation:  step.Op,
+		Operands:   operands,
+		Result:     math.FormatToken(step.Result),
+		Expression: math.Expression(step.List),
+	}
+}
@@ -0,0 +1,65 @@
+package basic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	steps, bug := Explain("(1 + 2) * √4 - 7 mod 4")
+	assert.Nil(t, bug, "Bug != nil")
+
+	want := []string{
+		"1 + 2 = 3 => 3 * √4 - 7 mod 4",
+		"√4 = 2 => 3 * 2 - 7 mod 4",
+		"3 * 2 = 6 => 6 - 7 mod 4",
+		"7 mod 4 = 3 => 6 - 3",
+		"6 - 3 = 3 => 3",
+	}
+	assert.Len(t, steps, len(want))
+	for i, step := range steps {
+		assert.Equal(t, want[i], step.String())
+	}
+
+	steps, bug = Explain("2 * -3 + Σ(k, k, 1, 4)")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, "sum(k, k, 1, 4) = 10 => 2 * (0 - 3) + 10", steps[0].String())
+	assert.Equal(t, "2 * (-3) = -6 => -6 + 10", steps[2].String())
+
+	steps, bug = Explain("1 + 2 / 0")
+	assert.ErrorIs(t, bug, ierr.IsInf)
+	assert.Len(t, steps, 2)
+}
+
+func TestExplainExpressions(t *testing.T) {
+	for _, expr := range []string{
+		"(0.5 + 4.5 - 1) * 10 * √(6-2) / 4^2",
+		"-(2 - 5) * 2^3^2 mod 7",
+		"max(1, -2 * 3, avg(4, 8)) - √(2 * 8)",
+		"[1, 2] · [3, -4] + 1",
+	} {
+		want, bug := Calculate(expr)
+		assert.Nilf(t, bug, "%s: Bug != nil", expr)
+
+		steps, bug := Explain(expr)
+		assert.Nilf(t, bug, "%s: Bug != nil", expr)
+
+		for _, step := range steps {
+			got, bug := Calculate(step.Expression)
+			assert.Nilf(t, bug, "%s: Bug != nil", step.Expression)
+			assert.InDeltaf(t, want, got, 1e-12, "%s", step.Expression)
+		}
+	}
+}
+
+func TestStepJSON(t *testing.T) {
+	steps, bug := ExplainWith("200 + 10%", Options{Percent: true})
+	assert.Nil(t, bug, "Bug != nil")
+
+	got, err := json.Marshal(steps)
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, `[{"operation":"+","operands":["200","20"],"result":"220","expression":"220"}]`, string(got))
+}
@@ -0,0 +1,41 @@
+package basic
+
+import "github.com/brianlewyn/go-calculator/internal/symbol"
+
+// FormatOptions represents the settings to write an expression with Format
+type FormatOptions struct {
+	// Parentheses wraps each operation inside another one in parentheses,
+	// otherwise only the ones that keep its meaning are written
+	//
+	//	1 + 2*3^2 => 1 + (2 * (3^2))
+	Parentheses bool
+
+	// Unicode writes the operators ×, ÷ and √, otherwise *, / and sqrt
+	//
+	//	2*sqrt(x)/3 => 2 × √x ÷ 3
+	Unicode bool
+}
+
+// Format returns a mathematical expression written in a canonical form with the given options and nil,
+// where there is a space around each operator but ^, a subtraction from zero is a negation
+// and the numbers are written without trailing zeros, otherwise it returns an empty string and an error.
+//
+// The expression written is solved like the given one, and formatting it again with the same options
+// returns it unchanged.
+//
+//	(1+2)*-x^2 => (1 + 2) * (-x)^2
+func Format(expression string, opts FormatOptions) (string, error) {
+	e, err := parse(expression)
+	if err != nil {
+		return "", err
+	}
+
+	return symbol.Format(e, opts.style()), nil
+}
+
+// !FormatOptions Methods
+
+// style returns the style of the symbol package
+func (o FormatOptions) style() symbol.Style {
+	return symbol.Style{Full: o.Parentheses, Unicode: o.Unicode}
+}
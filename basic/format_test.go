@@ -0,0 +1,45 @@
+package basic
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		expr string
+		opts FormatOptions
+		want string
+	}{
+		{expr: "(1+2)*-x^2", want: "(1 + 2) * (-x)^2"},
+		{expr: "1+2*3^2", opts: FormatOptions{Parentheses: true}, want: "1 + (2 * (3^2))"},
+		{expr: "2*sqrt(x)/3", opts: FormatOptions{Unicode: true}, want: "2 × √x ÷ 3"},
+		{expr: "2 × √x ÷ 3", want: "2 * sqrt(x) / 3"},
+		{expr: "  1.50+ π*r^2 ", want: "1.5 + π * r^2"},
+		{expr: "sin( -x ) mod 2", opts: FormatOptions{Unicode: true}, want: "sin(-x) mod 2"},
+		{expr: "9.81±0.02", want: "9.81 ± 0.02"},
+		{expr: "[1,2]·[3,4]", want: "[1, 2] · [3, 4]"},
+	}
+
+	for _, tt := range tests {
+		got, bug := Format(tt.expr, tt.opts)
+		assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+		assert.Equalf(t, tt.want, got, "%s", tt.expr)
+
+		again, bug := Format(got, tt.opts)
+		assert.Nilf(t, bug, "%s: Bug != nil", got)
+		assert.Equalf(t, got, again, "%s is not idempotent", tt.expr)
+
+		want, _ := Calculate(tt.expr)
+		res, _ := Calculate(got)
+		assert.Equalf(t, want, res, "%s is not solved like %s", got, tt.expr)
+	}
+
+	_, bug := Format("1 +", FormatOptions{})
+	assert.True(t, ierr.As(bug, ierr.CtxKindEnd), "Bug != CtxKindEnd")
+
+	_, bug = Format("1 ±", FormatOptions{})
+	assert.True(t, ierr.As(bug, ierr.CtxKindEnd), "Bug != CtxKindEnd")
+}
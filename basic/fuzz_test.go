@@ -0,0 +1,70 @@
+package basic
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+// seeds are expressions that cover each kind of token and the rewrites of the tokenizer
+var seeds = []string{
+	"", " ", "1 + 2 * 3", "√√-16", "-√√16", "√", "√(", "((1+2)*3)", "2^-3", "-(-1)", "+-+1", "1 +", ")(", "3 mod 0",
+	"π*2", "sin(π/2)", "1e308*10", "1..2", "f(", "max(1, 2, [3, 4])", "[1,2]·[3,4]", "[[1,2],[3,4]]^2", "[,]",
+	"sum(k^2, k, 1, 10)", "Σ(k, k)", "integrate(x, x, 0, 1)", "roots(1, 0, 1)", "linsolve([[1]], [])",
+	"200 + 10%", "%5", "√-4 + 2i", "9.81 ± 0.02", "5 ft to m", "3 m + 2 s", "$12.50 * 3", "12 EUR to", "2026-10-18 + 90 days",
+}
+
+func FuzzCalculate(f *testing.F) {
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, expression string) {
+		_, bug := Calculate(expression)
+		if ierr.As(bug, ierr.CtxPanic) {
+			t.Fatal(bug)
+		}
+	})
+}
+
+func FuzzCalculateWith(f *testing.F) {
+	for _, seed := range seeds {
+		f.Add(seed, true, true, true, true)
+	}
+
+	f.Fuzz(func(t *testing.T, expression string, percent, complex, units, dates bool) {
+		opts := Options{
+			Compensated: percent, Percent: percent, Complex: complex, Interval: complex && units,
+			Units: units, Money: units, Dates: dates,
+		}
+
+		_, bug := CalculateWith(expression, opts)
+		if ierr.As(bug, ierr.CtxPanic) {
+			t.Fatal(bug)
+		}
+	})
+}
+
+func TestHostileInput(t *testing.T) {
+	for _, expression := range append(seeds, "√√√-16", "√√(√-16)", "1 + √√-16 * 2", "[√√-16]", "(10%)", "√10%", "[1] + 10%") {
+		for _, opts := range []Options{{}, {Compensated: true, Percent: true, Complex: true}, {Units: true, Money: true, Dates: true}, {Interval: true}} {
+			assert.NotPanicsf(t, func() { CalculateWith(expression, opts) }, "%s", expression)
+
+			_, bug := CalculateWith(expression, opts)
+			assert.Falsef(t, ierr.As(bug, ierr.CtxPanic), "%s: %v", expression, bug)
+		}
+	}
+
+	t.Run("Recover a panic", func(t *testing.T) {
+		calculate := func(expression string) (res64 float64, err error) {
+			defer recoverPanic(expression, &err)
+			panic("index out of range")
+		}
+
+		_, bug := calculate("1 + 2")
+		assert.True(t, ierr.As(bug, ierr.CtxPanic), "Bug != CtxPanic")
+		assert.True(t, ierr.As(bug, ierr.Internal), "Bug != Internal")
+		assert.Contains(t, bug.Error(), `"1 + 2": index out of range`)
+	})
+}
@@ -0,0 +1,33 @@
+package basic
+
+import (
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/math"
+)
+
+// Integrate returns the integral of a mathematical expression with respect to the variable name
+// from a to b, the estimate of its absolute error and nil, otherwise it returns zero values
+// and an error, which is also the case if it does not converge.
+//
+//	x^2 from 0 to 3 => 9
+func Integrate(expression, name string, a, b float64) (value, errEst float64, err error) {
+	return IntegrateWith(expression, name, a, b, Options{})
+}
+
+// IntegrateWith returns the integral of a mathematical expression like Integrate with the given options,
+// using the global adaptive Gauss-Kronrod quadrature.
+func IntegrateWith(expression, name string, a, b float64, opts Options) (value, errEst float64, err error) {
+	if !isName(name) {
+		return 0, 0, ierr.NameMisspelled(name)
+	}
+
+	f := func(x float64) (float64, error) {
+		return evaluateFloat(expression, variable{name: name, value: x}, opts)
+	}
+
+	value, errEst, err = math.Integrate(f, a, b)
+	if err != nil {
+		return 0, 0, err
+	}
+	return value, errEst, nil
+}
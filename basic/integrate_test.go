@@ -0,0 +1,38 @@
+package basic
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrate(t *testing.T) {
+	tests := []struct {
+		expr string
+		name string
+		a, b float64
+		want float64
+	}{
+		{expr: "x^2", name: "x", a: 0, b: 3, want: 9},
+		{expr: "sin(t)", name: "t", a: 0, b: 3.141592653589793, want: 2},
+		{expr: "1/x", name: "x", a: 1, b: 2.718281828459045, want: 1},
+		{expr: "√(1 - x^2)", name: "x", a: -1, b: 1, want: 1.5707963267948966},
+	}
+
+	for _, tt := range tests {
+		got, errEst, bug := Integrate(tt.expr, tt.name, tt.a, tt.b)
+		assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+		assert.InDeltaf(t, tt.want, got, 1e-8, "%s", tt.expr)
+		assert.Lessf(t, errEst, 1e-8, "%s", tt.expr)
+	}
+
+	_, _, bug := Integrate("sin(1/x)", "x", 0, 1)
+	assert.True(t, ierr.As(bug, ierr.CtxNoConvergence), "Bug != CtxNoConvergence")
+
+	_, _, bug = Integrate("x + y", "x", 0, 1)
+	assert.True(t, ierr.As(bug, ierr.CtxNameUnknown), "Bug != CtxNameUnknown")
+
+	_, _, bug = Integrate("x", "2x", 0, 1)
+	assert.True(t, ierr.As(bug, ierr.CtxNameMisspelled), "Bug != CtxNameMisspelled")
+}
@@ -0,0 +1,66 @@
+package basic
+
+import (
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/internal/analyse"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/math"
+	"github.com/brianlewyn/go-calculator/internal/tokenize"
+)
+
+// Observer is notified of the work of a Calculator while it solves a script,
+// like each token, each analysis, each operation and each error, so that it can be audited
+type Observer interface {
+	// OnToken is called with each token of an expression once it is tokenized,
+	// like 2, x, sqrt, + or (
+	OnToken(token string)
+
+	// OnAnalyse is called with each expression once its tokens are analysed,
+	// and with the error of the analysis, which is nil if the syntax is correct
+	OnAnalyse(expression string, err error)
+
+	// OnOperation is called after each operation with the operator, like + or mod,
+	// or the name of the function, its operands and its result, where a sum, a product
+	// or an integral over a variable is one operation whose operands are its arguments
+	OnOperation(op string, operands []Result, result Result)
+
+	// OnError is called with the error of a script before it is returned
+	OnError(err error)
+}
+
+// observe solves a mathematical expression whose names are resolved by env like evaluate,
+// and notifies the observer of each token, the analysis and each operation.
+func observe(expression string, env math.Env, opts Options, o Observer) (value data.Token, err error) {
+	defer recoverPanic(expression, &err)
+
+	tokenizeOpts := opts.tokenize()
+	tokenizeOpts.Observe = func(token data.Token) {
+		o.OnToken(math.FormatToken(token))
+	}
+
+	list, err := tokenize.TokenizerWith(expression, tokenizeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = analyse.Analyser(list)
+	o.OnAnalyse(strings.TrimSpace(expression), err)
+	if err != nil {
+		return nil, err
+	}
+
+	mathOpts := opts.math()
+	mathOpts.Trace = func(step math.Step) {
+		operands := make([]Result, len(step.Operands), len(step.Operands)+len(step.Labels))
+		for i, operand := range step.Operands {
+			operands[i] = Result{value: operand}
+		}
+		for _, label := range step.Labels {
+			operands = append(operands, Result{label: label})
+		}
+		o.OnOperation(step.Op, operands, Result{value: step.Result})
+	}
+
+	return math.Evaluate(list, env, mathOpts)
+}
@@ -0,0 +1,200 @@
+package basic
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// !Data
+
+// Rates represents an in-memory table of exchange rates between currencies,
+// where each rate is exact and also works in the opposite direction
+type Rates struct {
+	pairs map[string]map[string]*big.Rat
+}
+
+// !Functions to create an instance with New
+
+// NewRates returns a new empty table of exchange rates
+func NewRates() *Rates {
+	return &Rates{pairs: make(map[string]map[string]*big.Rat)}
+}
+
+// LoadRates returns the table of exchange rates of a local JSON or CSV file and nil,
+// otherwise it returns nil and an error. See ReadRatesJSON and ReadRatesCSV for their formats.
+func LoadRates(path string) (*Rates, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		return ReadRatesJSON(file)
+	case ".csv":
+		return ReadRatesCSV(file)
+	default:
+		return nil, fmt.Errorf("unknown format of rates: %q", ext)
+	}
+}
+
+// ReadRatesJSON returns the table of exchange rates of a JSON object and nil,
+// otherwise it returns nil and an error.
+//
+//	{"USD": {"EUR": 0.92, "MXN": "17.05"}}
+func ReadRatesJSON(r io.Reader) (*Rates, error) {
+	var table map[string]map[string]json.Number
+
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	err := decoder.Decode(&table)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := NewRates()
+	for from, row := range table {
+		for to, rate := range row {
+			err = rates.Set(from, to, rate.String())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return rates, nil
+}
+
+// ReadRatesCSV returns the table of exchange rates of CSV records and nil,
+// otherwise it returns nil and an error, where the header is optional.
+//
+//	from,to,rate
+//	USD,EUR,0.92
+func ReadRatesCSV(r io.Reader) (*Rates, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) != 0 && strings.EqualFold(records[0][0], "from") {
+		records = records[1:]
+	}
+
+	rates := NewRates()
+	for _, record := range records {
+		err = rates.Set(record[0], record[1], record[2])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rates, nil
+}
+
+// !Rates Methods
+
+// Set keeps how much of the 'to' currency is one of the 'from' currency and returns nil,
+// otherwise it returns an error if a currency code or the rate is misspelled.
+//
+//	USD EUR 0.92 => 1 USD = 0.92 EUR
+func (r *Rates) Set(from, to, rate string) error {
+	for _, code := range []string{from, to} {
+		if !data.IsCurrency(code) {
+			return ierr.NameMisspelled(code)
+		}
+	}
+
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || value.Sign() <= 0 {
+		return ierr.NumberMisspelled(rate)
+	}
+
+	if r.pairs == nil {
+		r.pairs = make(map[string]map[string]*big.Rat)
+	}
+
+	if r.pairs[from] == nil {
+		r.pairs[from] = make(map[string]*big.Rat)
+	}
+
+	r.pairs[from][to] = value
+	return nil
+}
+
+// Rate returns how much of the 'to' currency is one of the 'from' currency and true,
+// otherwise returns false. The rate can be the one set, its inverse, or the product
+// of two of them through a third currency.
+func (r *Rates) Rate(from, to string) (*big.Rat, bool) {
+	if from == to {
+		return big.NewRat(1, 1), true
+	}
+
+	if rate, ok := r.direct(from, to); ok {
+		return rate, true
+	}
+
+	for _, via := range r.currencies() {
+		first, ok := r.direct(from, via)
+		if !ok {
+			continue
+		}
+
+		second, ok := r.direct(via, to)
+		if ok {
+			return first.Mul(first, second), true
+		}
+	}
+
+	return nil, false
+}
+
+// !Tool Methods
+
+// direct returns a new rate set from a currency to another one, or the inverse
+// of the one set in the opposite direction, and true, otherwise returns false
+func (r *Rates) direct(from, to string) (*big.Rat, bool) {
+	if rate, ok := r.pairs[from][to]; ok {
+		return new(big.Rat).Set(rate), true
+	}
+
+	if rate, ok := r.pairs[to][from]; ok {
+		return new(big.Rat).Inv(rate), true
+	}
+
+	return nil, false
+}
+
+// currencies returns the sorted codes of the currencies in the table
+func (r *Rates) currencies() []string {
+	seen := make(map[string]bool)
+	for from, row := range r.pairs {
+		seen[from] = true
+		for to := range row {
+			seen[to] = true
+		}
+	}
+
+	codes := make([]string, 0, len(seen))
+	for code := range seen {
+		codes = append(codes, code)
+	}
+
+	sort.Strings(codes)
+	return codes
+}
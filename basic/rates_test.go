@@ -0,0 +1,89 @@
+package basic
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRates(t *testing.T) {
+	rates := NewRates()
+	assert.Nil(t, rates.Set("USD", "EUR", "0.92"), "Bug != nil")
+	assert.Nil(t, rates.Set("USD", "MXN", "17.05"), "Bug != nil")
+
+	tests := []struct {
+		from, to string
+		want     *big.Rat
+	}{
+		{from: "USD", to: "EUR", want: big.NewRat(92, 100)},
+		{from: "EUR", to: "USD", want: big.NewRat(100, 92)},
+		{from: "EUR", to: "MXN", want: big.NewRat(1705, 92)},
+		{from: "MXN", to: "MXN", want: big.NewRat(1, 1)},
+	}
+
+	for _, tt := range tests {
+		rate, ok := rates.Rate(tt.from, tt.to)
+		if assert.Truef(t, ok, "%s:%s: no rate", tt.from, tt.to) {
+			assert.Equalf(t, tt.want.String(), rate.String(), "%s:%s", tt.from, tt.to)
+		}
+	}
+
+	_, ok := rates.Rate("USD", "GBP")
+	assert.False(t, ok, "USD:GBP has a rate")
+
+	bug := rates.Set("usd", "EUR", "1")
+	assert.True(t, ierr.As(bug, ierr.CtxNameMisspelled), "Bug != CtxNameMisspelled")
+
+	bug = rates.Set("USD", "EUR", "-1")
+	assert.True(t, ierr.As(bug, ierr.CtxNumberMisspelled), "Bug != CtxNumberMisspelled")
+}
+
+func TestReadRates(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		rates, bug := ReadRatesJSON(strings.NewReader(`{"USD": {"EUR": 0.92, "MXN": "17.05"}}`))
+		assert.Nil(t, bug, "Bug != nil")
+
+		rate, ok := rates.Rate("MXN", "USD")
+		assert.True(t, ok, "MXN:USD has no rate")
+		assert.Equal(t, big.NewRat(100, 1705).String(), rate.String())
+
+		_, bug = ReadRatesJSON(strings.NewReader(`{"USD": {"EUR": 0}}`))
+		assert.True(t, ierr.As(bug, ierr.CtxNumberMisspelled), "Bug != CtxNumberMisspelled")
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		rates, bug := ReadRatesCSV(strings.NewReader("from,to,rate\nUSD, EUR, 0.92\nGBP,USD,1.25\n"))
+		assert.Nil(t, bug, "Bug != nil")
+
+		rate, ok := rates.Rate("GBP", "EUR")
+		assert.True(t, ok, "GBP:EUR has no rate")
+		assert.Equal(t, big.NewRat(115, 100).String(), rate.String())
+
+		_, bug = ReadRatesCSV(strings.NewReader("USD,EUR\n"))
+		assert.NotNil(t, bug, "Bug == nil")
+	})
+
+	t.Run("Files", func(t *testing.T) {
+		dir := t.TempDir()
+
+		path := filepath.Join(dir, "rates.csv")
+		assert.Nil(t, os.WriteFile(path, []byte("USD,EUR,0.92\n"), 0o600))
+
+		rates, bug := LoadRates(path)
+		assert.Nil(t, bug, "Bug != nil")
+
+		_, ok := rates.Rate("EUR", "USD")
+		assert.True(t, ok, "EUR:USD has no rate")
+
+		path = filepath.Join(dir, "rates.txt")
+		assert.Nil(t, os.WriteFile(path, []byte("USD,EUR,0.92\n"), 0o600))
+
+		_, bug = LoadRates(path)
+		assert.NotNil(t, bug, "Bug == nil")
+	})
+}
@@ -0,0 +1,30 @@
+package basic
+
+import "github.com/brianlewyn/go-calculator/internal/symbol"
+
+// LaTeX returns a mathematical expression written in LaTeX and nil, where a division is a fraction,
+// a power is a superscript and the parentheses are the ones that keep its meaning,
+// otherwise it returns an empty string and an error.
+//
+//	(1+x)^2/√π => \frac{\left(1 + x\right)^{2}}{\sqrt{\pi}}
+func LaTeX(expression string) (string, error) {
+	e, err := parse(expression)
+	if err != nil {
+		return "", err
+	}
+
+	return symbol.LaTeX(e), nil
+}
+
+// MathML returns a mathematical expression written in presentation MathML and nil like LaTeX,
+// inside a math element, otherwise it returns an empty string and an error.
+//
+//	x^2 => <math xmlns="http://www.w3.org/1998/Math/MathML"><msup><mi>x</mi><mn>2</mn></msup></math>
+func MathML(expression string) (string, error) {
+	e, err := parse(expression)
+	if err != nil {
+		return "", err
+	}
+
+	return symbol.MathML(e), nil
+}
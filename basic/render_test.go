@@ -0,0 +1,30 @@
+package basic
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLaTeX(t *testing.T) {
+	got, bug := LaTeX("(1+x)^2/√π - 2*-y")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, `\frac{\left(1 + x\right)^{2}}{\sqrt{\pi}} - 2 \cdot \left(-y\right)`, got)
+
+	got, bug = LaTeX("linsolve([[2,1],[1,3]], [3,5])")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, `\operatorname{linsolve}\left(\left[\left[2, 1\right], \left[1, 3\right]\right], \left[3, 5\right]\right)`, got)
+
+	_, bug = LaTeX("1 +")
+	assert.True(t, ierr.As(bug, ierr.CtxKindEnd), "Bug != CtxKindEnd")
+}
+
+func TestMathML(t *testing.T) {
+	got, bug := MathML("√2/2")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, `<math xmlns="http://www.w3.org/1998/Math/MathML"><mfrac><msqrt><mn>2</mn></msqrt><mn>2</mn></mfrac></math>`, got)
+
+	_, bug = MathML("(1")
+	assert.NotNil(t, bug, "Bug == nil")
+}
@@ -0,0 +1,56 @@
+package basic
+
+import (
+	"github.com/brianlewyn/go-calculator/internal/analyse"
+	"github.com/brianlewyn/go-calculator/internal/math"
+	"github.com/brianlewyn/go-calculator/internal/rpn"
+)
+
+// CalculateRPN solves a mathematical expression in Reverse Polish Notation, whose numbers,
+// names and operators are separated by spaces, and returns the result and nil,
+// otherwise it returns a zero value and an error, which is also the case if an operator
+// has not enough operands or there are operands left without an operator.
+//
+// A function that has not a fixed number of arguments is written with it after ':'.
+//
+//	3 4 + 2 * => 14
+//	1 5 3 max:3 √ => 2.23606797749979
+func CalculateRPN(expression string) (res64 float64, err error) {
+	defer recoverPanic(expression, &err)
+
+	items, err := rpn.Parse(expression)
+	if err != nil {
+		return 0, err
+	}
+
+	list, err := rpn.ToList(items)
+	if err != nil {
+		return 0, err
+	}
+
+	err = analyse.Analyser(list)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Math(list, nil, math.Options{})
+}
+
+// ToRPN returns a mathematical expression written in Reverse Polish Notation and nil,
+// which CalculateRPN solves like Calculate solves the given one, otherwise it returns
+// an empty string and an error, which is also the case if a value can not be written in it, like an array.
+//
+//	(3 + 4) * 2 => 3 4 + 2 *
+func ToRPN(expression string) (string, error) {
+	list, err := analysed(expression, Options{}.tokenize())
+	if err != nil {
+		return "", err
+	}
+
+	items, err := rpn.FromList(list)
+	if err != nil {
+		return "", err
+	}
+
+	return rpn.String(items), nil
+}
@@ -0,0 +1,88 @@
+package basic
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateRPN(t *testing.T) {
+	tests := []struct {
+		expr string
+		want float64
+		as   ierr.KindOf
+	}{
+		{expr: "3 4 + 2 *", want: 14},
+		{expr: "  5 1 2 + 4 × + 3 - ", want: 14},
+		{expr: "2 3 ^ 2 ^", want: 64},
+		{expr: "16 √ √ -3 *", want: -6},
+		{expr: "π 2 / sin", want: 1},
+		{expr: "7 4 mod 10 2 % +", want: 3},
+		{expr: "1 5 3 max:3 √", want: 2.23606797749979},
+		{expr: "k 2 ^ k 1 10 sum:4", want: 385},
+		{expr: ""},
+		{expr: "3 +", as: ierr.CtxStackUnderflow},
+		{expr: "√", as: ierr.CtxStackUnderflow},
+		{expr: "1 2 3 +", as: ierr.CtxLeftoverOperands},
+		{expr: "1 2 max", as: ierr.CtxNameMisspelled},
+		{expr: "1 2 foo:2", as: ierr.CtxNameUnknown},
+		{expr: "1 sqrt:2", as: ierr.CtxArgumentCount},
+		{expr: "1 2 ( +", as: ierr.CtxRuneUnknown},
+		{expr: "1 2x +", as: ierr.CtxRuneUnknown},
+		{expr: "x 1 +", as: ierr.CtxNameUnknown},
+	}
+
+	for _, tt := range tests {
+		got, bug := CalculateRPN(tt.expr)
+		if tt.expr == "" {
+			assert.ErrorIs(t, bug, ierr.EmptyField)
+			continue
+		}
+
+		if tt.as != "" {
+			assert.Truef(t, ierr.As(bug, tt.as), "%s: Bug != %v: %v", tt.expr, tt.as, bug)
+			continue
+		}
+
+		assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+		assert.InDeltaf(t, tt.want, got, 1e-12, "%s: got: %v, want: %v", tt.expr, got, tt.want)
+	}
+}
+
+func TestToRPN(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "(3 + 4) * 2", want: "3 4 + 2 *"},
+		{expr: "3 + 4 * 2", want: "3 4 2 * +"},
+		{expr: "2^3^2", want: "2 3 ^ 2 ^"},
+		{expr: "√16^2", want: "16 √ 2 ^"},
+		{expr: "2^√3", want: "2 3 √ ^"},
+		{expr: "√√16 * √(2 + 7)^2", want: "16 √ √ 2 7 + √ 2 ^ *"},
+		{expr: "2 * 9.81 ± 0.02", want: "2 9.81 0.02 ± *"},
+		{expr: "-2 + 7 mod 4", want: "0 2 - 7 4 mod +"},
+		{expr: "max(1, 2 * π, sqrt(9)) / 2", want: "1 2 π * 9 sqrt max:3 2 /"},
+		{expr: "sum(k^2, k, 1, 10)", want: "k 2 ^ k 1 10 sum:4"},
+	}
+
+	for _, tt := range tests {
+		got, bug := ToRPN(tt.expr)
+		assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+		assert.Equalf(t, tt.want, got, "%s", tt.expr)
+
+		want, bugWant := Calculate(tt.expr)
+		res, bug := CalculateRPN(got)
+		if bugWant != nil {
+			assert.NotNilf(t, bug, "%s: Bug == nil", got)
+			continue
+		}
+
+		assert.Nilf(t, bug, "%s: Bug != nil", got)
+		assert.InDeltaf(t, want, res, 1e-12, "%s is not solved like %s", got, tt.expr)
+	}
+
+	_, bug := ToRPN("[1, 2] · [3, 4]")
+	assert.True(t, ierr.As(bug, ierr.CtxValueOperation), "Bug != CtxValueOperation")
+}
@@ -0,0 +1,38 @@
+package basic
+
+import "github.com/brianlewyn/go-calculator/internal/symbol"
+
+// ToSExpr returns a mathematical expression written as an S-expression and nil, where each operation
+// is a list that starts with its operator, a negation is a subtraction of one operand
+// and a square root starts with √, otherwise it returns an empty string and an error.
+//
+//	1 + 2*3 => (+ 1 (* 2 3))
+//	-√π => (- (√ π))
+func ToSExpr(expression string) (string, error) {
+	e, err := parse(expression)
+	if err != nil {
+		return "", err
+	}
+
+	return symbol.SExpr(e), nil
+}
+
+// FromSExpr returns an S-expression written as a mathematical expression that Calculate solves and nil,
+// where an operator with more than two operands is done from left to right,
+// otherwise it returns an empty string and an error.
+//
+// Writing the expression returned as an S-expression again returns the given one
+// when it has two operands for each operator but the negation.
+//
+//	(+ 1 (* 2 3)) => 1 + 2 × 3
+//	(mod (- 10 2 3) 4) => (10 - 2 - 3) mod 4
+func FromSExpr(sexpr string) (expression string, err error) {
+	defer recoverPanic(sexpr, &err)
+
+	e, err := symbol.ReadSExpr(sexpr)
+	if err != nil {
+		return "", err
+	}
+
+	return symbol.Format(e, symbol.Style{Unicode: true}), nil
+}
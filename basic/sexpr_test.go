@@ -0,0 +1,77 @@
+package basic
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSExpr(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "1 + 2*3", want: "(+ 1 (* 2 3))"},
+		{expr: "(10 - 2 - 3) mod 4", want: "(mod (- (- 10 2) 3) 4)"},
+		{expr: "-√π / 2^3^2", want: "(- (/ (√ π) (^ (^ 2 3) 2)))"},
+		{expr: "max(1, sqrt(16), -2) × 3", want: "(* (max 1 (√ 16) (- 2)) 3)"},
+		{expr: "2.50*(0-3)", want: "(* 2.5 (- 3))"},
+		{expr: "2 * 9.81 ± 0.02", want: "(* 2 (± 9.81 0.02))"},
+		{expr: "[1, 2]·[3, 4] mod 5", want: "(mod (· [1 2] [3 4]) 5)"},
+	}
+
+	for _, tt := range tests {
+		got, bug := ToSExpr(tt.expr)
+		assert.Nilf(t, bug, "%s: Bug != nil", tt.expr)
+		assert.Equalf(t, tt.want, got, "%s", tt.expr)
+
+		expr, bug := FromSExpr(got)
+		assert.Nilf(t, bug, "%s: Bug != nil", got)
+
+		again, bug := ToSExpr(expr)
+		assert.Nilf(t, bug, "%s: Bug != nil", expr)
+		assert.Equalf(t, got, again, "%s does not round-trip", tt.expr)
+
+		want, _ := Calculate(tt.expr)
+		res, bug := Calculate(expr)
+		assert.Nilf(t, bug, "%s: Bug != nil", expr)
+		assert.InDeltaf(t, want, res, 1e-12, "%s is not solved like %s", expr, tt.expr)
+	}
+
+	_, bug := ToSExpr("1 +")
+	assert.True(t, ierr.As(bug, ierr.CtxKindEnd), "Bug != CtxKindEnd")
+}
+
+func TestFromSExpr(t *testing.T) {
+	tests := []struct {
+		sexpr string
+		want  string
+		res   float64
+	}{
+		{sexpr: "(+ 1 (* 2 3))", want: "1 + 2 × 3", res: 7},
+		{sexpr: "(mod (- 10 2 3) 4)", want: "(10 - 2 - 3) mod 4", res: 1},
+		{sexpr: "(^ (√ 16) (- 2))", want: "√16^(-2)", res: 0.0625},
+		{sexpr: "(* 2 π)", want: "2 × π", res: 6.283185307179586},
+		{sexpr: "(- (max 1 2 3) -3)", want: "max(1, 2, 3) - (-3)", res: 6},
+		{sexpr: "(% 10 3)", want: "10 mod 3", res: 1},
+		{sexpr: "(± 9.81 0.02)", want: "9.81 ± 0.02", res: 9.81},
+		{sexpr: "(· [1 2] [3 4])", want: "[1, 2] · [3, 4]", res: 11},
+	}
+
+	for _, tt := range tests {
+		got, bug := FromSExpr(tt.sexpr)
+		assert.Nilf(t, bug, "%s: Bug != nil", tt.sexpr)
+		assert.Equalf(t, tt.want, got, "%s", tt.sexpr)
+
+		res, bug := Calculate(got)
+		assert.Nilf(t, bug, "%s: Bug != nil", got)
+		assert.InDeltaf(t, tt.res, res, 1e-12, "%s", got)
+	}
+
+	_, bug := FromSExpr("(+ 1 2")
+	assert.ErrorIs(t, bug, ierr.IncompleteLeft)
+
+	_, bug = FromSExpr("(/ 1)")
+	assert.True(t, ierr.As(bug, ierr.CtxArgumentCount), "Bug != CtxArgumentCount")
+}
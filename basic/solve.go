@@ -0,0 +1,120 @@
+package basic
+
+import (
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/math"
+)
+
+// DefaultTolerance is the default relative size of the last step for Solve to converge
+const DefaultTolerance = 1e-12
+
+// DefaultMaxIterations is the default limit of iterations of each method of Solve
+const DefaultMaxIterations = 100
+
+// SolveOptions represents the settings to solve an equation
+type SolveOptions struct {
+	// Tolerance is the relative size of the last step to converge,
+	// if it is zero or less DefaultTolerance is used
+	Tolerance float64
+
+	// MaxIterations is the limit of iterations of each method,
+	// if it is zero or less DefaultMaxIterations is used
+	MaxIterations int
+
+	// Options are the settings to solve both sides of the equation
+	Options Options
+}
+
+// variable resolves the only variable of an equation
+type variable struct {
+	name  string
+	value float64
+}
+
+// Solve returns the value of the variable name near the guess that solves an equation like
+// expression = value, or expression = 0 if it has not '=', and nil,
+// otherwise it returns a zero value and an error, which is also the case if it does not converge.
+//
+//	x^2 + 2*x = 100 => 9.04987562112089
+func Solve(equation, name string, guess float64) (float64, error) {
+	return SolveWith(equation, name, guess, SolveOptions{})
+}
+
+// SolveWith solves an equation like Solve with the given options, where the Newton-Raphson method
+// falls back to the Brent method inside a bracket around the guess if it does not converge.
+func SolveWith(equation, name string, guess float64, opts SolveOptions) (float64, error) {
+	if !isName(name) {
+		return 0, ierr.NameMisspelled(name)
+	}
+
+	left, right, ok := strings.Cut(equation, "=")
+	if !ok {
+		right = "0"
+	}
+
+	f := func(x float64) (float64, error) {
+		env := variable{name: name, value: x}
+
+		y, err := evaluateFloat(left, env, opts.Options)
+		if err != nil {
+			return 0, err
+		}
+
+		value, err := evaluateFloat(right, env, opts.Options)
+		return y - value, err
+	}
+
+	res64, err := math.Solve(f, guess, opts.tolerance(), opts.maxIterations())
+	if err != nil {
+		return 0, err
+	}
+	return res64, nil
+}
+
+// !SolveOptions Methods
+
+// tolerance returns the relative size of the last step to converge
+func (o SolveOptions) tolerance() float64 {
+	if o.Tolerance <= 0 {
+		return DefaultTolerance
+	}
+	return o.Tolerance
+}
+
+// maxIterations returns the limit of iterations of each method
+func (o SolveOptions) maxIterations() int {
+	if o.MaxIterations <= 0 {
+		return DefaultMaxIterations
+	}
+	return o.MaxIterations
+}
+
+// !Variable Methods
+
+// Var returns the value of the variable and true, otherwise returns false
+func (v variable) Var(name string) (data.Token, bool) {
+	if name != v.name {
+		return nil, false
+	}
+	return data.NewDecimalToken(v.value), true
+}
+
+// Func returns false, since an equation has no user-defined functions
+func (v variable) Func(string) (math.Func, bool) {
+	return nil, false
+}
+
+// !Tool Functions
+
+// evaluateFloat solves a mathematical expression whose names are resolved by env
+// and returns the result as a real number and nil, otherwise it returns a zero value and an error.
+func evaluateFloat(expression string, env math.Env, opts Options) (float64, error) {
+	value, err := evaluate(expression, env, opts)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float(value)
+}
@@ -0,0 +1,48 @@
+package basic
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		equation string
+		name     string
+		guess    float64
+		want     float64
+	}{
+		{equation: "x^2 + 2*x = 100", name: "x", guess: 1, want: 9.04987562112089},
+		{equation: "x^3 - x - 2", name: "x", guess: 1, want: 1.5213797068045676},
+		{equation: "cos(x) = x", name: "x", guess: 1, want: 0.7390851332151607},
+		{equation: "1000 * (1 + r)^10 = 2000", name: "r", guess: 0.1, want: 0.07177346253629313},
+		{equation: "100 = 2 * √t", name: "t", guess: 1, want: 2500},
+	}
+
+	for _, tt := range tests {
+		got, bug := Solve(tt.equation, tt.name, tt.guess)
+		assert.Nilf(t, bug, "%s: Bug != nil", tt.equation)
+		assert.InDeltaf(t, tt.want, got, 1e-9, "%s", tt.equation)
+	}
+
+	_, bug := Solve("x^2 = -1", "x", 1)
+	assert.True(t, ierr.As(bug, ierr.CtxNoConvergence), "Bug != CtxNoConvergence")
+
+	_, bug = Solve("1/x", "x", 1)
+	assert.True(t, ierr.As(bug, ierr.CtxNoConvergence), "Bug != CtxNoConvergence")
+
+	_, bug = SolveWith("x^3 - x - 2", "x", 100, SolveOptions{MaxIterations: 2})
+	assert.True(t, ierr.As(bug, ierr.CtxNoConvergence), "Bug != CtxNoConvergence")
+
+	_, bug = Solve("x + y = 1", "x", 1)
+	assert.True(t, ierr.As(bug, ierr.CtxNameUnknown), "Bug != CtxNameUnknown")
+
+	_, bug = Solve("x = 1", "2x", 1)
+	assert.True(t, ierr.As(bug, ierr.CtxNameMisspelled), "Bug != CtxNameMisspelled")
+
+	got, bug := SolveWith("x^2 = 2", "x", 1, SolveOptions{Tolerance: 1e-3})
+	assert.Nil(t, bug, "Bug != nil")
+	assert.InDelta(t, 1.4142135623730951, got, 1e-3)
+}
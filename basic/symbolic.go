@@ -0,0 +1,87 @@
+package basic
+
+import (
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/analyse"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/symbol"
+	"github.com/brianlewyn/go-calculator/internal/tokenize"
+)
+
+// Derive returns the derivative of an expression written after d/dx, where x can be
+// any variable, simplified and written as an expression that Calculate can solve, and nil,
+// otherwise it returns an empty string and an error.
+//
+//	d/dx (x^2 * sin(x)) = 2*x*sin(x) + x^2*cos(x)
+func Derive(expression string) (string, error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(expression), "d/d")
+	if !ok {
+		return "", ierr.NameMisspelled(expression)
+	}
+
+	end := strings.IndexFunc(rest, func(r rune) bool { return !data.IsWord(r) })
+	if end == -1 {
+		end = len(rest)
+	}
+
+	return Derivative(rest[end:], rest[:end])
+}
+
+// Derivative returns the derivative of a mathematical expression with respect to the variable name,
+// simplified and written as an expression that Calculate can solve, and nil,
+// otherwise it returns an empty string and an error.
+func Derivative(expression, name string) (string, error) {
+	if !isName(name) {
+		return "", ierr.NameMisspelled(name)
+	}
+
+	e, err := parse(expression)
+	if err != nil {
+		return "", err
+	}
+
+	d, err := symbol.Derive(e, name)
+	if err != nil {
+		return "", err
+	}
+
+	return d.String(), nil
+}
+
+// Simplify returns a mathematical expression simplified in a canonical form and nil,
+// where the numbers are folded, the identities and zeros are eliminated, and the like terms
+// are collected and sorted, so two equivalent expressions have the same form,
+// otherwise it returns an empty string and an error.
+//
+//	x*1 + 0 + (2+3)*x = 6*x
+//	1 + x + x^2 = x^2 + x + 1
+func Simplify(expression string) (string, error) {
+	e, err := parse(expression)
+	if err != nil {
+		return "", err
+	}
+
+	return symbol.Normalize(e).String(), nil
+}
+
+// !Tool Functions
+
+// parse returns the expression tree of a mathematical expression and nil,
+// otherwise it returns nil and an error.
+func parse(expression string) (e symbol.Expr, err error) {
+	defer recoverPanic(expression, &err)
+
+	list, err := tokenize.Tokenizer(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	err = analyse.Analyser(list)
+	if err != nil {
+		return nil, err
+	}
+
+	return symbol.Parse(list)
+}
@@ -0,0 +1,75 @@
+package basic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerive(t *testing.T) {
+	got, bug := Derive("d/dx (x^2 * sin(x))")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, "2*x*sin(x) + x^2*cos(x)", got)
+
+	got, bug = Derive("d/dt √(t^3 + 1)")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, "3*t^2/(2*√(t^3 + 1))", got)
+
+	_, bug = Derive("x^2")
+	assert.True(t, ierr.As(bug, ierr.CtxNameMisspelled), "Bug != CtxNameMisspelled")
+
+	_, bug = Derive("d/dx median(x, 1)")
+	assert.True(t, ierr.As(bug, ierr.CtxNotDifferentiable), "Bug != CtxNotDifferentiable")
+}
+
+func TestDerivative(t *testing.T) {
+	tests := []string{
+		"x^2 * sin(x)",
+		"x^x",
+		"2^(3*x) / (x + 1)",
+		"√(x^2 + 1) - ln(x) * cos(x)",
+		"tan(x)^2 + asin(x) + atan(1/x)",
+		"exp(-x^2) * log(x)",
+	}
+
+	const x, h = 0.7, 1e-6
+
+	for _, expr := range tests {
+		d, bug := Derivative(expr, "x")
+		assert.Nilf(t, bug, "%s: Bug != nil", expr)
+
+		calc := New()
+		_, bug = calc.Run(fmt.Sprintf("f(x) = %s; x = %v", expr, x))
+		assert.Nilf(t, bug, "%s: Bug != nil", expr)
+
+		right, bug := calc.Run(fmt.Sprintf("f(%v)", x+h))
+		assert.Nilf(t, bug, "%s: Bug != nil", expr)
+
+		left, bug := calc.Run(fmt.Sprintf("f(%v)", x-h))
+		assert.Nilf(t, bug, "%s: Bug != nil", expr)
+
+		want := (right - left) / (2 * h)
+
+		got, bug := calc.Run(d)
+		assert.Nilf(t, bug, "%s: Bug != nil", d)
+		assert.InDeltaf(t, want, got, 1e-6, "d/dx %s = %s", expr, d)
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	got, bug := Simplify("x*1 + 0 + (2+3)*x")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, "6*x", got)
+
+	a, bug := Simplify("(a + b)^2 * 2 - c/c")
+	assert.Nil(t, bug, "Bug != nil")
+
+	b, bug := Simplify("-1 + (b + a)*(a + b) + (b + a)^2")
+	assert.Nil(t, bug, "Bug != nil")
+	assert.Equal(t, a, b)
+
+	_, bug = Simplify("2 +* x")
+	assert.NotNil(t, bug, "Bug == nil")
+}
@@ -0,0 +1,72 @@
+// Command calc solves a mathematical expression and prints its result,
+// followed by the expression rendered in LaTeX or MathML if the flag -render is given.
+//
+//	calc "1 + 2 * 3"
+//	calc -render latex "(1 + x)^2 / √π"
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/basic"
+)
+
+// renderers are the ways to render an expression with the flag -render
+var renderers = map[string]func(expression string) (string, error){
+	"latex":  basic.LaTeX,
+	"mathml": basic.MathML,
+}
+
+func main() {
+	err := run(os.Args[1:], os.Stdout, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "calc:", err)
+		os.Exit(1)
+	}
+}
+
+// run solves the expression of the arguments and writes its result to stdout,
+// and the expression rendered if the flag -render is given, otherwise returns an error
+func run(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("calc", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	render := flags.String("render", "", "print the expression rendered as latex or mathml after the result")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	renderer, ok := renderers[*render]
+	if *render != "" && !ok {
+		return fmt.Errorf("unknown render %q, it can be latex or mathml", *render)
+	}
+
+	expression := strings.Join(flags.Args(), " ")
+
+	res, err := basic.Evaluate(expression, basic.Options{})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, res)
+
+	if renderer == nil {
+		return nil
+	}
+
+	rendered, err := renderer(expression)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, rendered)
+	return nil
+}
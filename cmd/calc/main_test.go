@@ -0,0 +1,34 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"1 + 2 * 3"}, want: "7\n"},
+		{args: []string{"-render", "latex", "(1 + 3)^2", "/", "√4"}, want: "8\n\\frac{\\left(1 + 3\\right)^{2}}{\\sqrt{4}}\n"},
+		{args: []string{"-render=mathml", "2^3"}, want: "8\n<math xmlns=\"http://www.w3.org/1998/Math/MathML\"><msup><mn>2</mn><mn>3</mn></msup></math>\n"},
+	}
+
+	for _, tt := range tests {
+		var stdout bytes.Buffer
+		bug := run(tt.args, &stdout, io.Discard)
+		assert.Nilf(t, bug, "%v: Bug != nil", tt.args)
+		assert.Equalf(t, tt.want, stdout.String(), "%v", tt.args)
+	}
+
+	bug := run([]string{"-render", "svg", "1"}, io.Discard, io.Discard)
+	assert.ErrorContains(t, bug, `unknown render "svg"`)
+
+	bug = run([]string{"1 +"}, io.Discard, io.Discard)
+	assert.True(t, ierr.As(bug, ierr.CtxKindEnd), "Bug != CtxKindEnd")
+}
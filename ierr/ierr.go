@@ -11,18 +11,50 @@ type KindOf string
 
 // !What kind of main error occurred?
 const (
-	Syntax = KindOf("syntax error")
-	Math   = KindOf("math error")
+	Syntax   = KindOf("syntax error")
+	Math     = KindOf("math error")
+	Internal = KindOf("internal error")
 )
 
 // !What kind of context error occurred?
 const (
-	CtxRuneUnknown      = KindOf("this is an unknown rune")
-	CtxNumberMisspelled = KindOf("this is a misspelled number")
-	CtxNumberLimit      = KindOf("this number exceeds the digit limit")
-	CtxKindNotTogether  = KindOf("these data types cannot be together")
-	CtxKindStart        = KindOf("this can't be the beginning")
-	CtxKindEnd          = KindOf("this can't be the end")
+	CtxRuneUnknown       = KindOf("this is an unknown rune")
+	CtxNumberMisspelled  = KindOf("this is a misspelled number")
+	CtxNumberLimit       = KindOf("this number exceeds the digit limit")
+	CtxKindNotTogether   = KindOf("these data types cannot be together")
+	CtxKindStart         = KindOf("this can't be the beginning")
+	CtxKindEnd           = KindOf("this can't be the end")
+	CtxKindOutside       = KindOf("this can't be outside of a function")
+	CtxPercentOff        = KindOf("this is a modulo without its right operand, a percentage needs the percent mode")
+	CtxPercentInfix      = KindOf("this is a percentage in the percent mode, a modulo needs the keyword mod")
+	CtxNameUnknown       = KindOf("this is an unknown name")
+	CtxNameMisspelled    = KindOf("this is a misspelled definition")
+	CtxNameReserved      = KindOf("this is a reserved name")
+	CtxArgumentCount     = KindOf("this is a wrong number of arguments")
+	CtxDepthLimit        = KindOf("this call exceeds the depth limit")
+	CtxNoConvergence     = KindOf("this does not converge")
+	CtxIterationLimit    = KindOf("this exceeds the iteration limit")
+	CtxNotReal           = KindOf("this is not a real number")
+	CtxValueOperation    = KindOf("this operation is not defined for these values")
+	CtxUnitUnknown       = KindOf("this is an unknown unit")
+	CtxDimensionMismatch = KindOf("these dimensions do not match")
+	CtxRateUnknown       = KindOf("there is no exchange rate between these currencies")
+	CtxDateMisspelled    = KindOf("this is a misspelled date or time")
+	CtxKindNotOperable   = KindOf("these data types cannot be operated together")
+	CtxShapeMismatch     = KindOf("these shapes do not match")
+	CtxNotDifferentiable = KindOf("this has no known derivative")
+	CtxSingularMatrix    = KindOf("this matrix is singular")
+	CtxLengthLimit       = KindOf("this expression exceeds the length limit")
+	CtxTokenLimit        = KindOf("this expression exceeds the token limit")
+	CtxNestingLimit      = KindOf("this expression exceeds the nesting limit")
+	CtxOperationLimit    = KindOf("this calculation exceeds the operation limit")
+	CtxCanceled          = KindOf("this calculation was canceled")
+	CtxPanic             = KindOf("this expression caused an unexpected panic")
+	CtxStackUnderflow    = KindOf("this operator has not enough operands")
+	CtxLeftoverOperands  = KindOf("these operands are left without an operator")
+	CtxNoRoots           = KindOf("this polynomial of degree 0 has no roots")
+	CtxBoundNotInteger   = KindOf("this bound of a series is not an integer")
+	CtxNotSymbolic       = KindOf("this has no symbolic meaning")
 )
 
 // !What error occurred?
@@ -49,6 +81,40 @@ type Kind struct {
 	k1, k2 rune
 }
 
+type Name struct {
+	n string
+}
+
+type Call struct {
+	n    string
+	args int
+}
+
+type Dimension struct {
+	d1, d2 string
+}
+
+type Exchange struct {
+	from, to string
+}
+
+type Operation struct {
+	k1, op, k2 rune
+}
+
+type Shape struct {
+	s1, s2 string
+}
+
+type Limit struct {
+	n int
+}
+
+type Expression struct {
+	expr  string
+	value any
+}
+
 // !Functions to create an instance with New
 
 func NewRune(r rune, i int) *Rune {
@@ -63,6 +129,38 @@ func NewKind(k1, k2 rune) *Kind {
 	return &Kind{k1: k1, k2: k2}
 }
 
+func NewName(n string) *Name {
+	return &Name{n: n}
+}
+
+func NewCall(n string, args int) *Call {
+	return &Call{n: n, args: args}
+}
+
+func NewDimension(d1, d2 string) *Dimension {
+	return &Dimension{d1: d1, d2: d2}
+}
+
+func NewExchange(from, to string) *Exchange {
+	return &Exchange{from: from, to: to}
+}
+
+func NewOperation(k1, op, k2 rune) *Operation {
+	return &Operation{k1: k1, op: op, k2: k2}
+}
+
+func NewShape(s1, s2 string) *Shape {
+	return &Shape{s1: s1, s2: s2}
+}
+
+func NewLimit(n int) *Limit {
+	return &Limit{n: n}
+}
+
+func NewExpression(expr string, value any) *Expression {
+	return &Expression{expr: expr, value: value}
+}
+
 // !The data error
 
 func (r Rune) Error() string {
@@ -80,6 +178,38 @@ func (k Kind) Error() string {
 	return fmt.Sprintf("%c:%c", k.k1, k.k2)
 }
 
+func (n Name) Error() string {
+	return n.n
+}
+
+func (c Call) Error() string {
+	return fmt.Sprintf("%s with %d arguments", c.n, c.args)
+}
+
+func (d Dimension) Error() string {
+	return fmt.Sprintf("%s:%s", d.d1, d.d2)
+}
+
+func (e Exchange) Error() string {
+	return fmt.Sprintf("%s:%s", e.from, e.to)
+}
+
+func (o Operation) Error() string {
+	return fmt.Sprintf("%c %c %c", o.k1, o.op, o.k2)
+}
+
+func (s Shape) Error() string {
+	return fmt.Sprintf("%s:%s", s.s1, s.s2)
+}
+
+func (l Limit) Error() string {
+	return fmt.Sprintf("%d", l.n)
+}
+
+func (e Expression) Error() string {
+	return fmt.Sprintf("%q: %v", e.expr, e.value)
+}
+
 // !Add context to the data error
 
 // RuneUnknown returns an error with the kind of context: CtxRuneUnknown
@@ -112,6 +242,163 @@ func KindEnd(k rune) error {
 	return doubleWrap(Syntax, CtxKindEnd, NewKind(k, 0))
 }
 
+// KindOutside returns an error with the kind of context: CtxKindOutside
+func KindOutside(k rune) error {
+	return doubleWrap(Syntax, CtxKindOutside, NewKind(k, 0))
+}
+
+// PercentOff returns an error with the kind of context: CtxPercentOff
+func PercentOff(k rune) error {
+	return doubleWrap(Syntax, CtxPercentOff, NewKind(k, 0))
+}
+
+// PercentInfix returns an error with the kind of context: CtxPercentInfix
+func PercentInfix(k1, k2 rune) error {
+	return doubleWrap(Syntax, CtxPercentInfix, NewKind(k1, k2))
+}
+
+// NameUnknown returns an error with the kind of context: CtxNameUnknown
+func NameUnknown(n string) error {
+	return doubleWrap(Math, CtxNameUnknown, NewName(n))
+}
+
+// NameMisspelled returns an error with the kind of context: CtxNameMisspelled
+func NameMisspelled(n string) error {
+	return doubleWrap(Syntax, CtxNameMisspelled, NewName(n))
+}
+
+// NameReserved returns an error with the kind of context: CtxNameReserved
+func NameReserved(n string) error {
+	return doubleWrap(Syntax, CtxNameReserved, NewName(n))
+}
+
+// ArgumentCount returns an error with the kind of context: CtxArgumentCount
+func ArgumentCount(n string, args int) error {
+	return doubleWrap(Math, CtxArgumentCount, NewCall(n, args))
+}
+
+// DepthLimit returns an error with the kind of context: CtxDepthLimit
+func DepthLimit(n string) error {
+	return doubleWrap(Math, CtxDepthLimit, NewName(n))
+}
+
+// NoConvergence returns an error with the kind of context: CtxNoConvergence
+func NoConvergence(n string) error {
+	return doubleWrap(Math, CtxNoConvergence, NewName(n))
+}
+
+// IterationLimit returns an error with the kind of context: CtxIterationLimit
+func IterationLimit(n string) error {
+	return doubleWrap(Math, CtxIterationLimit, NewName(n))
+}
+
+// NotReal returns an error with the kind of context: CtxNotReal
+func NotReal(n string) error {
+	return doubleWrap(Math, CtxNotReal, NewName(n))
+}
+
+// ValueOperation returns an error with the kind of context: CtxValueOperation
+func ValueOperation(k rune) error {
+	return doubleWrap(Math, CtxValueOperation, NewKind(k, 0))
+}
+
+// UnitUnknown returns an error with the kind of context: CtxUnitUnknown
+func UnitUnknown(n string) error {
+	return doubleWrap(Syntax, CtxUnitUnknown, NewName(n))
+}
+
+// DimensionMismatch returns an error with the kind of context: CtxDimensionMismatch
+func DimensionMismatch(d1, d2 string) error {
+	return doubleWrap(Math, CtxDimensionMismatch, NewDimension(d1, d2))
+}
+
+// RateUnknown returns an error with the kind of context: CtxRateUnknown
+func RateUnknown(from, to string) error {
+	return doubleWrap(Math, CtxRateUnknown, NewExchange(from, to))
+}
+
+// DateMisspelled returns an error with the kind of context: CtxDateMisspelled
+func DateMisspelled(n string) error {
+	return doubleWrap(Syntax, CtxDateMisspelled, NewNumber(n))
+}
+
+// KindNotOperable returns an error with the kind of context: CtxKindNotOperable
+func KindNotOperable(k1, op, k2 rune) error {
+	return doubleWrap(Math, CtxKindNotOperable, NewOperation(k1, op, k2))
+}
+
+// ShapeMismatch returns an error with the kind of context: CtxShapeMismatch
+func ShapeMismatch(s1, s2 string) error {
+	return doubleWrap(Math, CtxShapeMismatch, NewShape(s1, s2))
+}
+
+// NotDifferentiable returns an error with the kind of context: CtxNotDifferentiable
+func NotDifferentiable(n string) error {
+	return doubleWrap(Math, CtxNotDifferentiable, NewName(n))
+}
+
+// NotSymbolic returns an error with the kind of context: CtxNotSymbolic
+func NotSymbolic(k rune) error {
+	return doubleWrap(Syntax, CtxNotSymbolic, NewKind(k, 0))
+}
+
+// SingularMatrix returns an error with the kind of context: CtxSingularMatrix
+func SingularMatrix(n string) error {
+	return doubleWrap(Math, CtxSingularMatrix, NewName(n))
+}
+
+// LengthLimit returns an error with the kind of context: CtxLengthLimit
+func LengthLimit(n int) error {
+	return doubleWrap(Syntax, CtxLengthLimit, NewLimit(n))
+}
+
+// TokenLimit returns an error with the kind of context: CtxTokenLimit
+func TokenLimit(n int) error {
+	return doubleWrap(Syntax, CtxTokenLimit, NewLimit(n))
+}
+
+// NestingLimit returns an error with the kind of context: CtxNestingLimit
+func NestingLimit(n int) error {
+	return doubleWrap(Syntax, CtxNestingLimit, NewLimit(n))
+}
+
+// OperationLimit returns an error with the kind of context: CtxOperationLimit
+func OperationLimit(n int) error {
+	return doubleWrap(Math, CtxOperationLimit, NewLimit(n))
+}
+
+// Canceled returns an error with the kind of context: CtxCanceled,
+// which wraps the error of the context, like context.Canceled or context.DeadlineExceeded
+func Canceled(err error) error {
+	return doubleWrap(Math, CtxCanceled, err)
+}
+
+// Panic returns an error with the kind of context: CtxPanic,
+// which has the expression and the value of the panic
+func Panic(expr string, value any) error {
+	return doubleWrap(Internal, CtxPanic, NewExpression(expr, value))
+}
+
+// StackUnderflow returns an error with the kind of context: CtxStackUnderflow
+func StackUnderflow(n string) error {
+	return doubleWrap(Syntax, CtxStackUnderflow, NewName(n))
+}
+
+// LeftoverOperands returns an error with the kind of context: CtxLeftoverOperands
+func LeftoverOperands(n int) error {
+	return doubleWrap(Syntax, CtxLeftoverOperands, NewLimit(n))
+}
+
+// NoRoots returns an error with the kind of context: CtxNoRoots
+func NoRoots(n string) error {
+	return doubleWrap(Math, CtxNoRoots, NewName(n))
+}
+
+// BoundNotInteger returns an error with the kind of context: CtxBoundNotInteger
+func BoundNotInteger(n string) error {
+	return doubleWrap(Math, CtxBoundNotInteger, NewNumber(n))
+}
+
 // !Tool Functions
 
 // wrap adds a wrapper of type error to the already created error
@@ -6,10 +6,23 @@ import (
 	"github.com/brianlewyn/go-calculator/internal/doubly"
 )
 
+// call represents the parentheses being analysed, where args counts
+// the commas found if they belong to a function or an array
+type call struct {
+	name   string
+	args   int
+	isFunc bool
+}
+
 // Analyzer returns nil if the math expression has a correct sematic,
 // otherwise returns an error
 func Analyser(list *doubly.Doubly) error {
+	if list == nil || list.IsEmpty() {
+		return ierr.EmptyField
+	}
+
 	nL, nR := new(int), new(int)
+	calls := new([]call)
 
 	err := isFirstTokenCorrect(list.Head().Token())
 	if err != nil {
@@ -36,6 +49,11 @@ func Analyser(list *doubly.Doubly) error {
 		if err != nil {
 			return err
 		}
+
+		err = areCorrectArguments(temp, calls)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -58,6 +76,11 @@ func isLastTokenCorrect(token data.Token) error {
 	if data.IsLastToken(token.Kind()) {
 		return nil
 	}
+
+	if token.Kind() == data.ModToken {
+		return ierr.PercentOff(data.Mod)
+	}
+
 	return ierr.KindEnd(data.RuneMap[token.Kind()])
 }
 
@@ -75,6 +98,16 @@ func canBeTogether(current, next *doubly.Node) error {
 		return nil
 	}
 
+	// n %ₚ n
+	if kCurr == data.PercentToken && data.IsFirstToken(kNext) {
+		return ierr.PercentInfix(data.Mod, data.RuneMap[kNext])
+	}
+
+	// n % +
+	if kCurr == data.ModToken && data.CanTokensBeTogether(data.PercentToken, kNext) {
+		return ierr.PercentOff(data.Mod)
+	}
+
 	return ierr.KindNotTogether(data.RuneMap[kCurr], data.RuneMap[kNext])
 }
 
@@ -150,3 +183,55 @@ func areNLeftEqualToNRight(nLeft, nRight *int) error {
 
 	return ierr.IncompleteRight
 }
+
+// areCorrectArguments returns nil if every CommaToken separates the arguments of a function
+// or the elements of an array, and every builtin function has an accepted number of arguments,
+// otherwise returns an error
+//
+//	f(n, n) => nil
+//	(n, n) => error
+//	percentile(n) => error
+func areCorrectArguments(current *doubly.Node, calls *[]call) error {
+	n := len(*calls)
+
+	switch current.Token().Kind() {
+	case data.LeftToken:
+		*calls = append(*calls, newCall(current.Prev()))
+
+	case data.RightToken:
+		if n == 0 {
+			return nil
+		}
+
+		last := (*calls)[n-1]
+		*calls = (*calls)[:n-1]
+
+		if arity, ok := data.BuiltinMap[last.name]; ok && !arity.Accepts(last.args+1) {
+			return ierr.ArgumentCount(last.name, last.args+1)
+		}
+
+	case data.CommaToken:
+		if n == 0 || !(*calls)[n-1].isFunc {
+			return ierr.KindOutside(data.Comma)
+		}
+		(*calls)[n-1].args++
+	}
+
+	return nil
+}
+
+// newCall returns the call opened by a LeftToken after the 'prev' node
+func newCall(prev *doubly.Node) call {
+	if prev == nil {
+		return call{}
+	}
+
+	switch prev.Token().Kind() {
+	case data.FuncToken:
+		return call{name: prev.Token().(data.Word).Value(), isFunc: true}
+	case data.ArrayToken:
+		return call{isFunc: true}
+	}
+
+	return call{}
+}
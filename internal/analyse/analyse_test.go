@@ -83,6 +83,56 @@ func TestAnalyser(t *testing.T) {
 			list: toList("0)"),
 			// Try these: 0) (0)) ...
 		},
+		{
+			name: "Bug: Comma: Outside of a function",
+			list: toList("(1, 2)"),
+			as:   ierr.CtxKindOutside,
+			// Try these: 1, 2  (1, 2)  f((1, 2))
+		},
+		{
+			name: "Bug: Together: Argument missing",
+			list: toList("f(1,,2)"),
+			as:   ierr.CtxKindNotTogether,
+			// Try these: f(,1)  f(1,)  f(1,,2)
+		},
+		{
+			name: "Bug: Percent: A percentage without the percent mode",
+			list: toList("200 + 10% * 2"),
+			as:   ierr.CtxPercentOff,
+			// Try these: 10%  10% + 1  (10%)
+		},
+		{
+			name: "Bug: Arguments: Builtin function",
+			list: toList("percentile(50)"),
+			as:   ierr.CtxArgumentCount,
+			// Try these: var(1) stddev(1) percentile(50)
+		},
+		{
+			name: "Bug: Units: A conversion at the beginning",
+			list: toListWith("to m", tokenize.Options{Units: true}),
+			as:   ierr.CtxKindStart,
+			// Try these: to m  in ft
+		},
+		{
+			name: "Bug: Empty: An empty list",
+			list: doubly.New(),
+			is:   ierr.EmptyField,
+			// Try these: an empty list, a nil list
+		},
+		{
+			name: "Bug: Empty: A nil list",
+			is:   ierr.EmptyField,
+		},
+		{
+			name: "NotBug: Expression with units",
+			list: toListWith("3 km / (20 min) to m/s", tokenize.Options{Units: true}),
+			// Try this with a correct expression
+		},
+		{
+			name: "NotBug: Expression with names",
+			list: toList("f(x, g(y, 2)) * -z"),
+			// Try this with a correct expression
+		},
 		{
 			name: "NotBug: Expression",
 			list: toList("(0.5 + 4.5 - 1) * 10 * √(7-2) / 4^2"),
@@ -114,7 +164,12 @@ func TestAnalyser(t *testing.T) {
 
 // toList returns the expression in a raw Tokenized Linked List
 func toList(expression string) *doubly.Doubly {
-	list, err := tokenize.Tokenizer(expression)
+	return toListWith(expression, tokenize.Options{})
+}
+
+// toListWith returns the expression tokenized with the given options in a raw Tokenized Linked List
+func toListWith(expression string, opts tokenize.Options) *doubly.Doubly {
+	list, err := tokenize.TokenizerWith(expression, opts)
 	if err != nil {
 		fmt.Printf("ERROR: %s\n\n", err)
 	}
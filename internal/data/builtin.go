@@ -0,0 +1,84 @@
+package data
+
+// Arity represents how many arguments a function accepts,
+// where a negative Max means that the function is variadic
+type Arity struct {
+	Min, Max int
+}
+
+// BuiltinMap represents the functions that are always available:
+//
+//	sum(...), avg(...), median(...), mode(...), stddev(...), var(...),
+//	min(...), max(...), percentile(p, ...)
+//
+//	pmt(rate, nper, pv, [fv], [type]), pv(rate, nper, pmt, [fv], [type]),
+//	fv(rate, nper, pmt, [pv], [type]), nper(rate, pmt, pv, [fv], [type]),
+//	rate(nper, pmt, pv, [fv], [type], [guess]), npv(rate, ...), irr(...)
+//
+//	sqrt(x), exp(x), ln(x), log(x), sin(x), cos(x), tan(x), asin(x), acos(x), atan(x),
+//	abs(x), conj(x), arg(x), re(x), im(x)
+//
+//	dot(a, b), cross(a, b), transpose(A), det(A), inv(A), norm(a), linsolve(A, b), roots(...)
+//
+//	sum(expr, k, from, to), prod(expr, k, from, to), integrate(expr, x, a, b), Σ(...), ∏(...)
+var BuiltinMap = map[string]Arity{
+	"sum":        {Min: 1, Max: -1},
+	"avg":        {Min: 1, Max: -1},
+	"median":     {Min: 1, Max: -1},
+	"mode":       {Min: 1, Max: -1},
+	"stddev":     {Min: 2, Max: -1},
+	"var":        {Min: 2, Max: -1},
+	"min":        {Min: 1, Max: -1},
+	"max":        {Min: 1, Max: -1},
+	"percentile": {Min: 2, Max: -1},
+
+	"pmt":  {Min: 3, Max: 5},
+	"pv":   {Min: 3, Max: 5},
+	"fv":   {Min: 3, Max: 5},
+	"nper": {Min: 3, Max: 5},
+	"rate": {Min: 3, Max: 6},
+	"npv":  {Min: 2, Max: -1},
+	"irr":  {Min: 2, Max: -1},
+
+	"sqrt": {Min: 1, Max: 1},
+	"exp":  {Min: 1, Max: 1},
+	"ln":   {Min: 1, Max: 1},
+	"log":  {Min: 1, Max: 1},
+	"sin":  {Min: 1, Max: 1},
+	"cos":  {Min: 1, Max: 1},
+	"tan":  {Min: 1, Max: 1},
+	"asin": {Min: 1, Max: 1},
+	"acos": {Min: 1, Max: 1},
+	"atan": {Min: 1, Max: 1},
+	"abs":  {Min: 1, Max: 1},
+	"conj": {Min: 1, Max: 1},
+	"arg":  {Min: 1, Max: 1},
+	"re":   {Min: 1, Max: 1},
+	"im":   {Min: 1, Max: 1},
+
+	"dot":       {Min: 2, Max: 2},
+	"cross":     {Min: 2, Max: 2},
+	"transpose": {Min: 1, Max: 1},
+	"det":       {Min: 1, Max: 1},
+	"inv":       {Min: 1, Max: 1},
+	"norm":      {Min: 1, Max: 1},
+	"linsolve":  {Min: 2, Max: 2},
+	"roots":     {Min: 1, Max: -1},
+
+	"prod":      {Min: 4, Max: 4},
+	"integrate": {Min: 4, Max: 4},
+
+	string(SigmaSum):  {Min: 4, Max: 4},
+	string(PiProduct): {Min: 4, Max: 4},
+}
+
+// IsBuiltin returns true if name is a function that is always available
+func IsBuiltin(name string) bool {
+	_, ok := BuiltinMap[name]
+	return ok
+}
+
+// Accepts returns true if the arity accepts n arguments
+func (a Arity) Accepts(n int) bool {
+	return a.Min <= n && (a.Max < 0 || n <= a.Max)
+}
@@ -0,0 +1,64 @@
+package data
+
+import "time"
+
+// !Layouts
+
+// DateLayouts represent the layouts of the date and time literals:
+//
+//	2026-10-18, 2026-10-18T17:45, 2026-10-18T17:45:30, 17:45, 17:45:30
+var DateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04",
+	"2006-01-02T15:04:05",
+	"15:04",
+	"15:04:05",
+}
+
+// !Durations
+
+// DurationMap represents the units that can follow a number in the dates mode:
+//
+//	s, sec, second, seconds, min, minute, minutes, h, hour, hours,
+//	day, days, week, weeks
+var DurationMap = map[string]time.Duration{
+	"s":       time.Second,
+	"sec":     time.Second,
+	"second":  time.Second,
+	"seconds": time.Second,
+	"min":     time.Minute,
+	"minute":  time.Minute,
+	"minutes": time.Minute,
+	"h":       time.Hour,
+	"hour":    time.Hour,
+	"hours":   time.Hour,
+	"day":     24 * time.Hour,
+	"days":    24 * time.Hour,
+	"week":    7 * 24 * time.Hour,
+	"weeks":   7 * 24 * time.Hour,
+}
+
+// IsDuration returns true if word is a unit of time of the dates mode
+func IsDuration(word string) bool {
+	_, ok := DurationMap[word]
+	return ok
+}
+
+// ParseDate returns the time of a date or time literal and its layout and true,
+// otherwise returns false
+//
+//	2026-10-18 => 2026-10-18 00:00:00 UTC, "2006-01-02"
+//	17:45 => 0000-01-01 17:45:00 UTC, "15:04"
+func ParseDate(literal string) (time.Time, string, bool) {
+	for _, layout := range DateLayouts {
+		if len(literal) != len(layout) {
+			continue
+		}
+
+		t, err := time.Parse(layout, literal)
+		if err == nil {
+			return t, layout, true
+		}
+	}
+	return time.Time{}, "", false
+}
@@ -18,14 +18,33 @@ const (
 	PiToken    // Pi number = 'π'
 
 	NumToken // Number = n
+
+	VarToken   // Variable = v
+	FuncToken  // Function = f
+	CommaToken // Comma = ','
+
+	PercentToken // Percent = '%'
+
+	UnitToken    // Unit = u
+	ConvertToken // Convert = '→'
+
+	CurrencyToken // Currency = '¤'
+
+	DateToken     // Date or time = d
+	DurationToken // Duration = t
+
+	ArrayToken   // Array = '['
+	ProductToken // Product = '·'
+
+	PlusMinusToken // PlusMinus = '±'
 )
 
 // !For each TokenKind
 
 // TokenKindMap represent the follow kinds:
 //
-//	%, *, +, -, /, (, ), ^, √   π
-//	1  2  3  4  5  6  7  8  9  10
+//	%, *, +, -, /, (, ), ^, √   π   ,   ·   ±   ×  ÷
+//	1  2  3  4  5  6  7  8  9  10  14  22  23  2  5
 var TokenKindMap = map[rune]TokenKind{
 	Mod:   ModToken,
 	Mul:   MulToken,
@@ -37,26 +56,47 @@ var TokenKindMap = map[rune]TokenKind{
 	Pow:   PowToken,
 	Root:  RootToken,
 	Pi:    PiToken,
+	Comma: CommaToken,
+
+	Product:   ProductToken,
+	PlusMinus: PlusMinusToken,
+
+	Times:  MulToken,
+	Obelus: DivToken,
 }
 
 // !For each TokenKind group
 
 // IsAddSubToken returns true if r is:
 //
-//	n, π
+//	n, π, v, u, d
 func IsNumPiToken(kind TokenKind) bool {
-	return kind == NumToken || kind == PiToken
+	switch kind {
+	case NumToken:
+	case PiToken:
+	case VarToken:
+	case UnitToken:
+	case DateToken:
+	default:
+		return false
+	}
+	return true
 }
 
 // IsFirstToken returs true if kind is:
 //
-//	√, (, π, n
+//	√, (, π, n, v, f, u, d, [
 func IsFirstToken(kind TokenKind) bool {
 	switch kind {
+	case ArrayToken:
+	case DateToken:
 	case RootToken:
 	case LeftToken:
 	case PiToken:
 	case NumToken:
+	case VarToken:
+	case FuncToken:
+	case UnitToken:
 	default:
 		return false
 	}
@@ -65,12 +105,18 @@ func IsFirstToken(kind TokenKind) bool {
 
 // IsLastToken returns true if kind is:
 //
-//	), π, n
+//	), π, n, v, %, u, ¤, d, t
 func IsLastToken(kind TokenKind) bool {
 	switch kind {
+	case DateToken:
+	case DurationToken:
 	case RightToken:
 	case PiToken:
 	case NumToken:
+	case VarToken:
+	case PercentToken:
+	case UnitToken:
+	case CurrencyToken:
 	default:
 		return false
 	}
@@ -79,9 +125,11 @@ func IsLastToken(kind TokenKind) bool {
 
 // IsOperatorToken returns true if kind is:
 //
-//	%, *, +, -, /
+//	%, *, +, -, /, ·, ±
 func IsOperatorToken(kind TokenKind) bool {
 	switch kind {
+	case ProductToken:
+	case PlusMinusToken:
 	case ModToken:
 	case MulToken:
 	case AddToken:
@@ -117,10 +165,20 @@ CanTokensBeTogether returns true if k1 & k2 are:
 	k1= ( k2= (, n, π, √
 	k1= ^ k2= (, n, π, √
 	k1= √ k2= (, n, π, √
+	k1= , k2= (, n, π, √
+
+	k1= π k2= %, *, +, -, /, ^, ), ,, %ₚ, →
+	k1= n k2= %, *, +, -, /, ^, ), ,, %ₚ, →
+	k1= ) k2= %, *, +, -, /, ^, ), ,, %ₚ, →
+	k1= %ₚ k2= %, *, +, -, /, ^, ), ,, →
+
+	k1= f k2= (
+	k1= [ k2= (
+	k1= → k2= u, ¤
 
-	k1= π k2= %, *, +, -, /, ^, )
-	k1= n k2= %, *, +, -, /, ^, )
-	k1= ) k2= %, *, +, -, /, ^, )
+where n also stands for v, u, ¤, d and t, f and [ can be wherever n can be a k2,
+%ₚ is the postfix percentage, → is the conversion to a unit, [ opens an array
+and · and ± can be wherever * can be
 */
 func CanTokensBeTogether(k1, k2 TokenKind) bool {
 	switch k1 {
@@ -132,19 +190,30 @@ func CanTokensBeTogether(k1, k2 TokenKind) bool {
 	case LeftToken:
 	case PowToken:
 	case RootToken:
-	default: // Token (Pi||Num||Right)
+	case CommaToken:
+	case ProductToken:
+	case PlusMinusToken:
+	case FuncToken, ArrayToken:
+		return k2 == LeftToken
+	case ConvertToken:
+		return k2 == UnitToken || k2 == CurrencyToken
+	case PercentToken:
 		return isOperatorPowRight(k2)
+	default: // Token (Pi||Num||Var||Unit||Currency||Date||Duration||Right)
+		return isOperatorPowRight(k2) || k2 == PercentToken
 	}
 	return isLeftNumPiRoot(k2)
 }
 
 // isOperatorPowRight returns true if kind is:
 //
-//	%, *, +, -, /, ^, )
+//	%, *, +, -, /, ^, ), ,, →
 func isOperatorPowRight(kind TokenKind) bool {
 	switch kind {
 	case PowToken:
 	case RightToken:
+	case CommaToken:
+	case ConvertToken:
 	default:
 		return IsOperatorToken(kind)
 	}
@@ -153,13 +222,20 @@ func isOperatorPowRight(kind TokenKind) bool {
 
 // isLeftNumPiRoot returns true if kind is:
 //
-//	(, n, π, √
+//	(, n, π, √, v, f, u, ¤, d, t, [
 func isLeftNumPiRoot(kind TokenKind) bool {
 	switch kind {
+	case ArrayToken:
+	case DateToken:
+	case DurationToken:
 	case LeftToken:
 	case NumToken:
 	case PiToken:
 	case RootToken:
+	case VarToken:
+	case FuncToken:
+	case UnitToken:
+	case CurrencyToken:
 	default:
 		return false
 	}
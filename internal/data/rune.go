@@ -1,5 +1,7 @@
 package data
 
+import "unicode/utf8"
+
 // !Data
 
 // DigitLimit is the limit of digits of a float64 type
@@ -22,6 +24,27 @@ const (
 	Dot rune = '.' // Dot = '.'
 	Num rune = 'n' // Num = 'n'
 
+	Var   rune = 'v' // Variable = 'v'
+	Func  rune = 'f' // Function = 'f'
+	Comma rune = ',' // Comma = ','
+	Under rune = '_' // Underscore = '_'
+
+	Measure  rune = 'u' // Measure unit = 'u'
+	Convert  rune = '→' // Convert = '→'
+	Currency rune = '¤' // Currency = '¤'
+	Date     rune = 'd' // Date or time = 'd'
+	Duration rune = 't' // Duration = 't'
+	Colon    rune = ':' // Colon = ':'
+
+	LeftBracket  rune = '[' // Left Bracket = '['
+	RightBracket rune = ']' // Right Bracket = ']'
+	Product      rune = '·' // Dot Product = '·'
+	PlusMinus    rune = '±' // Plus-Minus = '±'
+	SigmaSum     rune = 'Σ' // Summation = 'Σ'
+	PiProduct    rune = '∏' // Product of a series = '∏'
+	Times        rune = '×' // Multiplication sign = '×'
+	Obelus       rune = '÷' // Division sign = '÷'
+
 	Gap rune = ' ' // Gap = ' '
 )
 
@@ -29,8 +52,8 @@ const (
 
 // RuneMap represent the follow symbols:
 //
-//	1  2  3  4  5  6  7  8  9  10  11
-//	%, *, +, -, /, (, ), ^, √,  π,  n
+//	1  2  3  4  5  6  7  8  9  10  11  12  13  14  15  16  17  18  19  20  21  22  23
+//	%, *, +, -, /, (, ), ^, √,  π,  n,  v,  f,  ,,  %,  u,  →,  ¤,  d,  t,  [,  ·,  ±
 var RuneMap = map[TokenKind]rune{
 	ModToken:   Mod,
 	MulToken:   Mul,
@@ -43,6 +66,94 @@ var RuneMap = map[TokenKind]rune{
 	RootToken:  Root,
 	PiToken:    Pi,
 	NumToken:   Num,
+	VarToken:   Var,
+	FuncToken:  Func,
+	CommaToken: Comma,
+
+	PercentToken: Mod,
+	UnitToken:    Measure,
+	ConvertToken: Convert,
+
+	CurrencyToken: Currency,
+	DateToken:     Date,
+	DurationToken: Duration,
+
+	ArrayToken:   LeftBracket,
+	ProductToken: Product,
+
+	PlusMinusToken: PlusMinus,
+}
+
+// !Keywords
+
+// KeywordMap represent the follow words:
+//
+//	mod
+var KeywordMap = map[string]TokenKind{
+	"mod": ModToken,
+}
+
+// IsKeyword returns true if name is a keyword
+func IsKeyword(name string) bool {
+	_, ok := KeywordMap[name]
+	return ok
+}
+
+// !Series
+
+// SeriesMap represent the symbols of a series that are spelled as the name of a function:
+//
+//	Σ => sum, ∏ => prod
+var SeriesMap = map[rune]string{
+	SigmaSum:  "sum",
+	PiProduct: "prod",
+}
+
+// SeriesName returns the name of the function spelled by the symbol of a series and true,
+// otherwise returns false
+//
+//	Σ => sum
+func SeriesName(word string) (string, bool) {
+	r, size := utf8.DecodeRuneInString(word)
+	name, ok := SeriesMap[r]
+	return name, ok && size == len(word)
+}
+
+// !Imaginary unit
+
+// IsImaginary returns true if word is:
+//
+//	i, j
+func IsImaginary(word string) bool {
+	return word == "i" || word == "j"
+}
+
+// !Currencies
+
+// CurrencySymbolMap represent the symbols that can precede a number in the money mode:
+//
+//	$, €, £, ¥
+var CurrencySymbolMap = map[rune]string{
+	'$': "USD",
+	'€': "EUR",
+	'£': "GBP",
+	'¥': "JPY",
+}
+
+// IsCurrency returns true if word is a currency code of three uppercase letters:
+//
+//	USD, EUR, MXN, ...
+func IsCurrency(word string) bool {
+	if len(word) != 3 {
+		return false
+	}
+
+	for _, r := range word {
+		if r < 'A' || 'Z' < r {
+			return false
+		}
+	}
+	return true
 }
 
 // !For each rune group
@@ -60,3 +171,17 @@ func IsNumber(r rune) bool {
 func IsDecimal(r rune) bool {
 	return IsNumber(r) || Dot == r
 }
+
+// IsLetter returns true if r is:
+//
+// a-z, A-Z, _
+func IsLetter(r rune) bool {
+	return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || Under == r
+}
+
+// IsWord returns true if r is:
+//
+// a-z, A-Z, _, 0-9
+func IsWord(r rune) bool {
+	return IsLetter(r) || IsNumber(r)
+}
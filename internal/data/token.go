@@ -1,5 +1,10 @@
 package data
 
+import (
+	"math/big"
+	"time"
+)
+
 // Token represents both a token Symbol and a token Number from the list
 type Token interface {
 	Kind() TokenKind
@@ -10,16 +15,87 @@ type Symbol struct {
 	kind TokenKind
 }
 
-// Number represents a number token from the list
+// Number represents a number token from the list,
+// where unary is true if it is the zero written before a minus that is a negation
 type Number struct {
 	kind  TokenKind
 	value string
+	unary bool
+}
+
+// Word represents a variable or function name token from the list
+type Word struct {
+	kind  TokenKind
+	value string
 }
 
-// Decimal represents a decimal number token from the list
+// Decimal represents a decimal number token from the list,
+// where unary is true if it is the zero written before a minus that is a negation
 type Decimal struct {
 	kind  TokenKind
 	value float64
+	unary bool
+}
+
+// Complex represents a complex number token from the list
+type Complex struct {
+	kind  TokenKind
+	value complex128
+}
+
+// Quantity represents a number token with a unit from the list,
+// where value is in that unit
+type Quantity struct {
+	kind  TokenKind
+	value float64
+	unit  Unit
+}
+
+// Money represents an amount of a currency token from the list
+type Money struct {
+	kind     TokenKind
+	value    *big.Rat
+	currency string
+}
+
+// Moment represents a date or time token from the list,
+// where layout is the one of its literal
+type Moment struct {
+	kind   TokenKind
+	value  time.Time
+	layout string
+}
+
+// Span represents a duration token from the list
+type Span struct {
+	kind  TokenKind
+	value time.Duration
+}
+
+// Interval represents a token from the list whose exact value is between lo and hi
+type Interval struct {
+	kind   TokenKind
+	lo, hi float64
+}
+
+// Uncertain represents a number token with a standard uncertainty from the list,
+// where terms are the contributions of each independent source of uncertainty
+type Uncertain struct {
+	kind  TokenKind
+	value float64
+	terms map[*Source]float64
+}
+
+// Source represents an independent source of uncertainty, like a measurement
+type Source struct {
+	sigma float64
+}
+
+// Array represents a vector or a matrix token from the list,
+// where each element of a matrix is one of its rows
+type Array struct {
+	kind  TokenKind
+	value []Token
 }
 
 // NewSymbolToken returns a token Symbol
@@ -32,22 +108,181 @@ func NewNumberToken(value string) Token {
 	return Number{kind: NumToken, value: value}
 }
 
+// NewZeroToken returns a token Number of the zero written before a minus that is a negation:
+//
+//	-x => 0-x
+func NewZeroToken() Token {
+	return Number{kind: NumToken, value: "0", unary: true}
+}
+
+// NewWordToken returns a token Word of the given kind
+func NewWordToken(kind TokenKind, value string) Token {
+	return Word{kind: kind, value: value}
+}
+
 // NewDecimalToken returns a token Decimal
 func NewDecimalToken(value float64) Token {
 	return Decimal{kind: NumToken, value: value}
 }
 
+// NewZeroDecimalToken returns a token Decimal of the zero written before a minus that is a negation
+func NewZeroDecimalToken() Token {
+	return Decimal{kind: NumToken, unary: true}
+}
+
+// NewPercentToken returns a token Decimal of a percentage,
+// where value is already divided by 100
+func NewPercentToken(value float64) Token {
+	return Decimal{kind: PercentToken, value: value}
+}
+
+// NewComplexToken returns a token Complex
+func NewComplexToken(value complex128) Token {
+	return Complex{kind: NumToken, value: value}
+}
+
+// NewQuantityToken returns a token Quantity, where value is in the given unit
+func NewQuantityToken(value float64, unit Unit) Token {
+	return Quantity{kind: NumToken, value: value, unit: unit}
+}
+
+// NewMoneyToken returns a token Money, where value is an exact amount of the currency
+func NewMoneyToken(value *big.Rat, currency string) Token {
+	return Money{kind: NumToken, value: value, currency: currency}
+}
+
+// NewMomentToken returns a token Moment, which is shown with the given layout
+func NewMomentToken(value time.Time, layout string) Token {
+	return Moment{kind: NumToken, value: value, layout: layout}
+}
+
+// NewSpanToken returns a token Span
+func NewSpanToken(value time.Duration) Token {
+	return Span{kind: NumToken, value: value}
+}
+
+// NewIntervalToken returns a token Interval, where lo is not greater than hi
+func NewIntervalToken(lo, hi float64) Token {
+	return Interval{kind: NumToken, lo: lo, hi: hi}
+}
+
+// NewPercentIntervalToken returns a token Interval of a percentage,
+// where lo and hi are already divided by 100
+func NewPercentIntervalToken(lo, hi float64) Token {
+	return Interval{kind: PercentToken, lo: lo, hi: hi}
+}
+
+// NewUncertainToken returns a token Uncertain, where each term is the partial derivative
+// of the value with respect to a source times the uncertainty of that source
+func NewUncertainToken(value float64, terms map[*Source]float64) Token {
+	return Uncertain{kind: NumToken, value: value, terms: terms}
+}
+
+// NewPercentUncertainToken returns a token Uncertain of a percentage,
+// where value and terms are already divided by 100
+func NewPercentUncertainToken(value float64, terms map[*Source]float64) Token {
+	return Uncertain{kind: PercentToken, value: value, terms: terms}
+}
+
+// NewSource returns a new independent source of uncertainty
+func NewSource(sigma float64) *Source {
+	return &Source{sigma: sigma}
+}
+
+// NewArrayToken returns a token Array of the given elements
+func NewArrayToken(value []Token) Token {
+	return Array{kind: NumToken, value: value}
+}
+
 // Kind returns the token Symbol type
 func (s Symbol) Kind() TokenKind { return s.kind }
 
 // Kind returns the token Number type
 func (n Number) Kind() TokenKind { return n.kind }
 
+// Kind returns the token Word type
+func (w Word) Kind() TokenKind { return w.kind }
+
 // Kind returns the token Decimal type
 func (d Decimal) Kind() TokenKind { return d.kind }
 
 // Value returns the token Number value
 func (n Number) Value() string { return n.value }
 
+// IsUnary returns true if the token Number is the zero written before a minus that is a negation
+func (n Number) IsUnary() bool { return n.unary }
+
+// Kind returns the token Complex type
+func (c Complex) Kind() TokenKind { return c.kind }
+
+// Value returns the token Word value
+func (w Word) Value() string { return w.value }
+
 // Value returns the token Decimal value
 func (d Decimal) Value() float64 { return d.value }
+
+// IsUnary returns true if the token Decimal is the zero written before a minus that is a negation
+func (d Decimal) IsUnary() bool { return d.unary }
+
+// Value returns the token Complex value
+func (c Complex) Value() complex128 { return c.value }
+
+// Kind returns the token Quantity type
+func (q Quantity) Kind() TokenKind { return q.kind }
+
+// Value returns the token Quantity value in its unit
+func (q Quantity) Value() float64 { return q.value }
+
+// Unit returns the token Quantity unit
+func (q Quantity) Unit() Unit { return q.unit }
+
+// Kind returns the token Money type
+func (m Money) Kind() TokenKind { return m.kind }
+
+// Value returns the token Money exact amount
+func (m Money) Value() *big.Rat { return m.value }
+
+// Currency returns the token Money currency code
+func (m Money) Currency() string { return m.currency }
+
+// Kind returns the token Moment type
+func (m Moment) Kind() TokenKind { return m.kind }
+
+// Value returns the token Moment value
+func (m Moment) Value() time.Time { return m.value }
+
+// Layout returns the token Moment layout
+func (m Moment) Layout() string { return m.layout }
+
+// Kind returns the token Span type
+func (s Span) Kind() TokenKind { return s.kind }
+
+// Value returns the token Span value
+func (s Span) Value() time.Duration { return s.value }
+
+// Kind returns the token Interval type
+func (i Interval) Kind() TokenKind { return i.kind }
+
+// Lo returns the token Interval lower bound
+func (i Interval) Lo() float64 { return i.lo }
+
+// Hi returns the token Interval upper bound
+func (i Interval) Hi() float64 { return i.hi }
+
+// Kind returns the token Uncertain type
+func (u Uncertain) Kind() TokenKind { return u.kind }
+
+// Value returns the token Uncertain value
+func (u Uncertain) Value() float64 { return u.value }
+
+// Terms returns the token Uncertain contributions of each source
+func (u Uncertain) Terms() map[*Source]float64 { return u.terms }
+
+// Sigma returns the standard uncertainty of the source
+func (s *Source) Sigma() float64 { return s.sigma }
+
+// Kind returns the token Array type
+func (a Array) Kind() TokenKind { return a.kind }
+
+// Value returns the token Array elements
+func (a Array) Value() []Token { return a.value }
@@ -0,0 +1,318 @@
+package data
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Dimension represents the exponents of the SI base quantities of a unit:
+//
+//	m, kg, s, A, K, mol, cd
+type Dimension [7]int8
+
+// Unit represents a unit of measurement, where Scale is the value
+// of one unit in the SI base units of its dimension
+type Unit struct {
+	Name  string
+	Scale float64
+	Dim   Dimension
+}
+
+// !Dimensions
+
+var (
+	dimLength      = Dimension{1, 0, 0, 0, 0, 0, 0}
+	dimMass        = Dimension{0, 1, 0, 0, 0, 0, 0}
+	dimTime        = Dimension{0, 0, 1, 0, 0, 0, 0}
+	dimCurrent     = Dimension{0, 0, 0, 1, 0, 0, 0}
+	dimTemperature = Dimension{0, 0, 0, 0, 1, 0, 0}
+	dimAmount      = Dimension{0, 0, 0, 0, 0, 1, 0}
+	dimLuminosity  = Dimension{0, 0, 0, 0, 0, 0, 1}
+
+	dimArea      = Dimension{2, 0, 0, 0, 0, 0, 0}
+	dimVolume    = Dimension{3, 0, 0, 0, 0, 0, 0}
+	dimFrequency = Dimension{0, 0, -1, 0, 0, 0, 0}
+	dimSpeed     = Dimension{1, 0, -1, 0, 0, 0, 0}
+	dimForce     = Dimension{1, 1, -2, 0, 0, 0, 0}
+	dimPressure  = Dimension{-1, 1, -2, 0, 0, 0, 0}
+	dimEnergy    = Dimension{2, 1, -2, 0, 0, 0, 0}
+	dimPower     = Dimension{2, 1, -3, 0, 0, 0, 0}
+	dimCharge    = Dimension{0, 0, 1, 1, 0, 0, 0}
+	dimVoltage   = Dimension{2, 1, -3, -1, 0, 0, 0}
+)
+
+// dimSymbols are the symbols of the SI base units in the order they are formatted
+var dimSymbols = [...]struct {
+	index  int
+	symbol string
+}{
+	{1, "kg"}, {0, "m"}, {2, "s"}, {3, "A"}, {4, "K"}, {5, "mol"}, {6, "cd"},
+}
+
+// !Units
+
+// UnitMap represents the units that can follow a number in the units mode,
+// where the SI ones also accept a prefix like k in km:
+//
+//	m, g, s, A, K, mol, cd, Hz, N, Pa, J, W, C, V, L
+//	min, h, day, inch, in, ft, yd, mi, mph, lb, oz, gal, ha
+var UnitMap = map[string]Unit{
+	"m":   {Scale: 1, Dim: dimLength},
+	"g":   {Scale: 1e-3, Dim: dimMass},
+	"s":   {Scale: 1, Dim: dimTime},
+	"A":   {Scale: 1, Dim: dimCurrent},
+	"K":   {Scale: 1, Dim: dimTemperature},
+	"mol": {Scale: 1, Dim: dimAmount},
+	"cd":  {Scale: 1, Dim: dimLuminosity},
+	"Hz":  {Scale: 1, Dim: dimFrequency},
+	"N":   {Scale: 1, Dim: dimForce},
+	"Pa":  {Scale: 1, Dim: dimPressure},
+	"J":   {Scale: 1, Dim: dimEnergy},
+	"W":   {Scale: 1, Dim: dimPower},
+	"C":   {Scale: 1, Dim: dimCharge},
+	"V":   {Scale: 1, Dim: dimVoltage},
+	"L":   {Scale: 1e-3, Dim: dimVolume},
+
+	"min":  {Scale: 60, Dim: dimTime},
+	"h":    {Scale: 3600, Dim: dimTime},
+	"day":  {Scale: 86400, Dim: dimTime},
+	"inch": {Scale: 0.0254, Dim: dimLength},
+	"in":   {Scale: 0.0254, Dim: dimLength},
+	"ft":   {Scale: 0.3048, Dim: dimLength},
+	"yd":   {Scale: 0.9144, Dim: dimLength},
+	"mi":   {Scale: 1609.344, Dim: dimLength},
+	"mph":  {Scale: 0.44704, Dim: dimSpeed},
+	"lb":   {Scale: 0.45359237, Dim: dimMass},
+	"oz":   {Scale: 0.028349523125, Dim: dimMass},
+	"gal":  {Scale: 0.003785411784, Dim: dimVolume},
+	"ha":   {Scale: 1e4, Dim: dimArea},
+}
+
+// prefixMap represents the SI prefixes
+//
+//	T, G, M, k, d, c, m, u, n, p
+var prefixMap = map[string]float64{
+	"T": 1e12,
+	"G": 1e9,
+	"M": 1e6,
+	"k": 1e3,
+	"d": 1e-1,
+	"c": 1e-2,
+	"m": 1e-3,
+	"u": 1e-6,
+	"n": 1e-9,
+	"p": 1e-12,
+}
+
+// prefixable are the units of UnitMap that accept a prefix
+var prefixable = map[string]bool{
+	"m": true, "g": true, "s": true, "A": true, "K": true, "mol": true, "cd": true,
+	"Hz": true, "N": true, "Pa": true, "J": true, "W": true, "C": true, "V": true, "L": true,
+}
+
+// !Conversion keywords
+
+// IsConversion returns true if word converts a value to a unit in the units mode,
+// where in is also the unit inch when no unit follows it:
+//
+//	to, in
+func IsConversion(word string) bool {
+	return word == "to" || word == "in"
+}
+
+// !Functions for units
+
+// LookupUnit returns the unit of the given name, which can have a prefix, and true,
+// otherwise returns false
+//
+//	km => k + m
+func LookupUnit(name string) (Unit, bool) {
+	if unit, ok := UnitMap[name]; ok {
+		unit.Name = name
+		return unit, true
+	}
+
+	for prefix, scale := range prefixMap {
+		base, ok := strings.CutPrefix(name, prefix)
+		if !ok || !prefixable[base] {
+			continue
+		}
+
+		unit := UnitMap[base]
+		return Unit{Name: name, Scale: scale * unit.Scale, Dim: unit.Dim}, true
+	}
+
+	return Unit{}, false
+}
+
+// IsUnit returns true if name is a unit, which can have a prefix
+func IsUnit(name string) bool {
+	_, ok := LookupUnit(name)
+	return ok
+}
+
+// ParseUnit returns the unit of a product or quotient of units with integer powers and true,
+// otherwise returns false
+//
+//	km/h, m/s^2, kg*m^2/s^2, 1/s
+func ParseUnit(expr string) (Unit, bool) {
+	expr = strings.ReplaceAll(expr, string(Gap), "")
+	if expr == "" {
+		return Unit{}, false
+	}
+
+	unit := Unit{Name: expr, Scale: 1}
+	sign := int8(1)
+
+	for i, term := range splitUnitTerms(expr) {
+		if i > 0 {
+			sign = 1
+			if term[0] == byte(Div) {
+				sign = -1
+			}
+			term = term[1:]
+		}
+
+		base, exp, ok := cutUnitPower(term)
+		if !ok {
+			return Unit{}, false
+		}
+
+		if base == "1" && i == 0 && exp == 1 {
+			continue
+		}
+
+		u, ok := LookupUnit(base)
+		if !ok {
+			return Unit{}, false
+		}
+
+		unit = unit.Mul(u.Pow(sign * exp))
+	}
+
+	unit.Name = expr
+	return unit, true
+}
+
+// !Unit Methods
+
+// Mul returns the product of the units without a name
+func (u Unit) Mul(v Unit) Unit {
+	return Unit{Scale: u.Scale * v.Scale, Dim: u.Dim.Add(v.Dim)}
+}
+
+// Pow returns the unit raised to an integer power without a name
+func (u Unit) Pow(n int8) Unit {
+	scale := 1.0
+	for i := int8(0); i < n; i++ {
+		scale *= u.Scale
+	}
+	for i := int8(0); i > n; i-- {
+		scale /= u.Scale
+	}
+	return Unit{Scale: scale, Dim: u.Dim.Scale(n)}
+}
+
+// !Dimension Methods
+
+// Add returns the dimension of the product of two quantities
+func (d Dimension) Add(e Dimension) Dimension {
+	for i := range d {
+		d[i] += e[i]
+	}
+	return d
+}
+
+// Sub returns the dimension of the quotient of two quantities
+func (d Dimension) Sub(e Dimension) Dimension {
+	for i := range d {
+		d[i] -= e[i]
+	}
+	return d
+}
+
+// Scale returns the dimension of a quantity raised to the n power
+func (d Dimension) Scale(n int8) Dimension {
+	for i := range d {
+		d[i] *= n
+	}
+	return d
+}
+
+// IsZero returns true if the dimension is of a dimensionless quantity
+func (d Dimension) IsZero() bool {
+	return d == Dimension{}
+}
+
+// String returns the dimension in SI base units
+//
+//	1, m, m/s, kg*m^2/s^2, 1/s
+func (d Dimension) String() string {
+	var num, den []string
+
+	for _, s := range dimSymbols {
+		switch exp := d[s.index]; {
+		case exp > 0:
+			num = append(num, withPower(s.symbol, exp))
+		case exp < 0:
+			den = append(den, withPower(s.symbol, -exp))
+		}
+	}
+
+	str := strings.Join(num, string(Mul))
+	if str == "" {
+		str = "1"
+	}
+
+	for _, s := range den {
+		str += string(Div) + s
+	}
+
+	return str
+}
+
+// !Tool Functions
+
+// splitUnitTerms returns the terms of a product or quotient of units,
+// where every term but the first one keeps its operator
+//
+//	kg*m^2/s^2 => kg *m^2 /s^2
+func splitUnitTerms(expr string) []string {
+	var terms []string
+
+	start := 0
+	for i, r := range expr {
+		if r == Mul || r == Div {
+			terms = append(terms, expr[start:i])
+			start = i
+		}
+	}
+
+	return append(terms, expr[start:])
+}
+
+// cutUnitPower returns the base and the integer power of a unit term and true,
+// otherwise returns false
+//
+//	m^2 => m 2
+func cutUnitPower(term string) (string, int8, bool) {
+	base, power, ok := strings.Cut(term, string(Pow))
+	if !ok {
+		return base, 1, true
+	}
+
+	exp, err := strconv.ParseInt(power, 10, 8)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return base, int8(exp), true
+}
+
+// withPower returns the symbol with its power unless it is one
+func withPower(symbol string, exp int8) string {
+	if exp == 1 {
+		return symbol
+	}
+	return symbol + string(Pow) + strconv.Itoa(int(exp))
+}
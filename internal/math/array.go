@@ -0,0 +1,530 @@
+package math
+
+import (
+	"math"
+	"math/cmplx"
+	"strconv"
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/doubly"
+)
+
+// operateArray returns the value token of operating x and y element by element, where at least one of them
+// is an Array and the one with fewer axes is repeated along the other, and nil, otherwise returns nil
+// and an error if their shapes do not match:
+//
+//	[1, 2] + [3, 4] = [4, 6]
+//	[[1, 2], [3, 4]] * 10 = [[10, 20], [30, 40]]
+//	[[1, 2], [3, 4]] + [10, 20] = [[11, 22], [13, 24]]
+//	[1, 2, 3] · [4, 5, 6] = 32
+//	[1, 2] + [1, 2, 3] => error
+func operateArray(kind data.TokenKind, x, y data.Token, opts Options) (data.Token, error) {
+	if kind == data.ProductToken {
+		return product(x, y, opts)
+	}
+
+	sx, sy := shapeOf(x), shapeOf(y)
+	if !canBroadcast(sx, sy) {
+		return nil, ierr.ShapeMismatch(formatShape(sx), formatShape(sy))
+	}
+
+	switch {
+	case len(sx) > len(sy):
+		return mapArray(x.(data.Array), func(e data.Token) (data.Token, error) {
+			return operate(kind, e, y, opts)
+		})
+
+	case len(sx) < len(sy):
+		return mapArray(y.(data.Array), func(e data.Token) (data.Token, error) {
+			return operate(kind, x, e, opts)
+		})
+	}
+
+	ey := y.(data.Array).Value()
+	values := make([]data.Token, len(ey))
+
+	for i, e := range x.(data.Array).Value() {
+		value, err := operate(kind, e, ey[i], opts)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return data.NewArrayToken(values), nil
+}
+
+// product returns the value token of the dot product of two vectors, or the matrix product
+// if any of them is a matrix, and nil, otherwise returns nil and an error if their shapes do not match
+//
+//	[1, 2, 3] · [4, 5, 6] = 32
+//	[[1, 2], [3, 4]] · [1, 1] = [3, 7]
+//	[1, 1] · [[1, 2], [3, 4]] = [4, 6]
+func product(x, y data.Token, opts Options) (data.Token, error) {
+	sx, sy := shapeOf(x), shapeOf(y)
+
+	if len(sx) == 0 || len(sy) == 0 {
+		return operate(data.MulToken, x, y, opts)
+	}
+
+	if len(sx) > 2 || len(sy) > 2 {
+		return nil, ierr.ValueOperation(data.Product)
+	}
+
+	if sx[len(sx)-1] != sy[0] {
+		return nil, ierr.ShapeMismatch(formatShape(sx), formatShape(sy))
+	}
+
+	if len(sx) == 2 {
+		return mapArray(x.(data.Array), func(row data.Token) (data.Token, error) {
+			return product(row, y, opts)
+		})
+	}
+
+	if len(sy) == 2 {
+		return mapArray(transpose(y.(data.Array)), func(column data.Token) (data.Token, error) {
+			return product(x, column, opts)
+		})
+	}
+
+	ey := y.(data.Array).Value()
+
+	var sum data.Token
+	for i, e := range x.(data.Array).Value() {
+		value, err := operate(data.MulToken, e, ey[i], opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if sum != nil {
+			value, err = operate(data.AddToken, sum, value, opts)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		sum = value
+	}
+
+	return sum, nil
+}
+
+// doArray replaces the ArrayToken before 'left' with an Array of the elements
+// between 'left' and 'right' and then deletes them, otherwise returns an error
+// if the elements do not have the same shape
+//
+//	[(1, 2)] => [1, 2]
+//	[([(1, 2)], [(3)])] => error
+func doArray(list *doubly.Doubly, left, right *doubly.Node) error {
+	node := left.Prev()
+	values := popArguments(list, left, right)
+	first := shapeOf(values[0])
+
+	for _, value := range values[1:] {
+		if shape := shapeOf(value); !equalShapes(first, shape) {
+			return ierr.ShapeMismatch(formatShape(first), formatShape(shape))
+		}
+	}
+
+	node.Update(data.NewArrayToken(values))
+	return nil
+}
+
+// !Builtin Functions
+
+// dot returns the dot product of two vectors, or the matrix product if any of them is a matrix
+func dot(args []data.Token) (data.Token, error) {
+	return product(args[0], args[1], Options{})
+}
+
+// cross returns the cross product of two vectors of three elements
+func cross(args []data.Token) (data.Token, error) {
+	for _, arg := range args {
+		if shape := shapeOf(arg); !equalShapes(shape, []int{3}) {
+			return nil, ierr.ShapeMismatch(formatShape(shape), formatShape([]int{3}))
+		}
+	}
+
+	a, b := args[0].(data.Array).Value(), args[1].(data.Array).Value()
+	values := make([]data.Token, 3)
+
+	for i := range values {
+		j, k := (i+1)%3, (i+2)%3
+
+		x, err := operate(data.MulToken, a[j], b[k], Options{})
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := operate(data.MulToken, a[k], b[j], Options{})
+		if err != nil {
+			return nil, err
+		}
+
+		values[i], err = operate(data.SubToken, x, y, Options{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return data.NewArrayToken(values), nil
+}
+
+// transposeOf returns the transpose of a matrix, where a vector is a row
+// and its transpose is a column, and a scalar is its own transpose
+func transposeOf(args []data.Token) (data.Token, error) {
+	switch len(shapeOf(args[0])) {
+	case 0:
+		return args[0], nil
+
+	case 1:
+		return mapArray(args[0].(data.Array), func(e data.Token) (data.Token, error) {
+			return data.NewArrayToken([]data.Token{e}), nil
+		})
+
+	case 2:
+		return transpose(args[0].(data.Array)), nil
+	}
+
+	return nil, ierr.ValueOperation(data.Func)
+}
+
+// det returns the determinant of a square matrix with the LU decomposition
+func det(args []data.Token) (data.Token, error) {
+	a, err := toSquare(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res64 := 1.0
+	for i := range a {
+		p := pivot(a, i)
+		if a[p][i] == 0 {
+			return data.NewDecimalToken(0), nil
+		}
+
+		if p != i {
+			a[i], a[p] = a[p], a[i]
+			res64 = -res64
+		}
+
+		res64 *= a[i][i]
+		eliminate(a, i, i+1)
+	}
+
+	return data.NewDecimalToken(res64), nil
+}
+
+// inv returns the inverse of a square matrix with the Gauss-Jordan elimination,
+// otherwise returns an error if it is singular
+func inv(args []data.Token) (data.Token, error) {
+	a, err := toSquare(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	n, scale := len(a), maxAbs(a)
+	for i := range a {
+		a[i] = append(a[i], make([]float64, n)...)
+		a[i][n+i] = 1
+	}
+
+	for i := range a {
+		p := pivot(a, i)
+		if isSingular(a[p][i], n, scale) {
+			return nil, ierr.SingularMatrix("inv")
+		}
+
+		a[i], a[p] = a[p], a[i]
+		for j, pv := len(a[i])-1, a[i][i]; j >= i; j-- {
+			a[i][j] /= pv
+		}
+
+		eliminate(a, i, 0)
+	}
+
+	rows := make([]data.Token, n)
+	for i := range a {
+		rows[i] = fromFloats(a[i][n:])
+	}
+
+	return data.NewArrayToken(rows), nil
+}
+
+// linsolve returns the solution of a square system of linear equations,
+// where b is a vector or a column
+func linsolve(args []data.Token) (data.Token, error) {
+	a, err := toSquare(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := toFloats(args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) != len(a) {
+		return nil, ierr.ShapeMismatch(formatShape(shapeOf(args[1])), formatShape([]int{len(a)}))
+	}
+
+	x, err := LinSolve(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromFloats(x), nil
+}
+
+// norm returns the Euclidean norm of a vector, the Frobenius norm of a matrix
+// or the absolute value of a scalar
+func norm(args []data.Token) (data.Token, error) {
+	var res64 float64
+
+	for _, e := range flatten(args[0]) {
+		if z, ok := e.(data.Complex); ok {
+			res64 = math.Hypot(res64, cmplx.Abs(z.Value()))
+			continue
+		}
+
+		x, err := toFloat(e)
+		if err != nil {
+			return nil, err
+		}
+
+		res64 = math.Hypot(res64, x)
+	}
+
+	return data.NewDecimalToken(res64), nil
+}
+
+// LinSolve returns the solution of the square system of linear equations a*x = b and nil
+// with the Gaussian elimination with partial pivoting, otherwise returns nil and an error
+// if the shapes do not match or a is singular
+func LinSolve(a [][]float64, b []float64) ([]float64, error) {
+	n := len(a)
+	if n == 0 || len(b) != n {
+		return nil, ierr.ShapeMismatch(formatShape([]int{n, n}), formatShape([]int{len(b)}))
+	}
+
+	m := make([][]float64, n)
+	for i, row := range a {
+		if len(row) != n {
+			return nil, ierr.ShapeMismatch(formatShape([]int{n, len(row)}), "nxn")
+		}
+
+		m[i] = append(append(make([]float64, 0, n+1), row...), b[i])
+	}
+
+	scale := maxAbs(a)
+	for i := range m {
+		p := pivot(m, i)
+		if isSingular(m[p][i], n, scale) {
+			return nil, ierr.SingularMatrix("linsolve")
+		}
+
+		m[i], m[p] = m[p], m[i]
+		eliminate(m, i, i+1)
+	}
+
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := m[i][n]
+		for j := i + 1; j < n; j++ {
+			sum -= m[i][j] * x[j]
+		}
+		x[i] = sum / m[i][i]
+	}
+
+	return x, nil
+}
+
+// !Tool Functions
+
+// isArray returns true if the value token is an Array, otherwise returns false
+func isArray(value data.Token) bool {
+	_, ok := value.(data.Array)
+	return ok
+}
+
+// mapArray returns a new Array with the result of fn for each element of the array and nil,
+// otherwise returns nil and the first error of fn
+func mapArray(a data.Array, fn func(e data.Token) (data.Token, error)) (data.Token, error) {
+	values := make([]data.Token, len(a.Value()))
+
+	for i, e := range a.Value() {
+		value, err := fn(e)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return data.NewArrayToken(values), nil
+}
+
+// flatten returns the elements of the value token that are not an Array in row order,
+// where a value that is not an Array is its only element
+func flatten(value data.Token) []data.Token {
+	a, ok := value.(data.Array)
+	if !ok {
+		return []data.Token{value}
+	}
+
+	var values []data.Token
+	for _, e := range a.Value() {
+		values = append(values, flatten(e)...)
+	}
+	return values
+}
+
+// transpose returns a new matrix whose rows are the columns of the matrix
+func transpose(a data.Array) data.Array {
+	rows := a.Value()
+	columns := make([]data.Token, len(rows[0].(data.Array).Value()))
+
+	for j := range columns {
+		column := make([]data.Token, len(rows))
+		for i, row := range rows {
+			column[i] = row.(data.Array).Value()[j]
+		}
+		columns[j] = data.NewArrayToken(column)
+	}
+
+	return data.NewArrayToken(columns).(data.Array)
+}
+
+// toSquare returns a copy of a square matrix as float64 and nil,
+// otherwise returns nil and an error if it is not a square matrix of real numbers
+func toSquare(value data.Token) ([][]float64, error) {
+	shape := shapeOf(value)
+	if len(shape) != 2 || shape[0] != shape[1] {
+		return nil, ierr.ShapeMismatch(formatShape(shape), "nxn")
+	}
+
+	a := make([][]float64, shape[0])
+	for i, row := range value.(data.Array).Value() {
+		xs, err := toFloats(row.(data.Array).Value())
+		if err != nil {
+			return nil, err
+		}
+		a[i] = xs
+	}
+
+	return a, nil
+}
+
+// maxAbs returns the largest absolute value of the matrix
+func maxAbs(a [][]float64) float64 {
+	var res64 float64
+	for _, row := range a {
+		for _, x := range row {
+			res64 = math.Max(res64, math.Abs(x))
+		}
+	}
+	return res64
+}
+
+// isSingular returns true if the pivot value of a matrix of order n is a rounding error
+// of its largest absolute value, otherwise returns false
+func isSingular(value float64, n int, scale float64) bool {
+	return math.Abs(value) <= float64(n)*epsilon*scale
+}
+
+// pivot returns the row from i to the last one with the largest absolute value in the column i
+func pivot(a [][]float64, i int) int {
+	p := i
+	for r := i + 1; r < len(a); r++ {
+		if math.Abs(a[r][i]) > math.Abs(a[p][i]) {
+			p = r
+		}
+	}
+	return p
+}
+
+// eliminate subtracts the row i from the rows from 'from' to the last one, but the row i itself,
+// so that they have a zero in the column i
+func eliminate(a [][]float64, i, from int) {
+	for r := from; r < len(a); r++ {
+		if r == i {
+			continue
+		}
+
+		f := a[r][i] / a[i][i]
+		for c := i; c < len(a[r]); c++ {
+			a[r][c] -= f * a[i][c]
+		}
+	}
+}
+
+// fromFloats returns the numbers as a vector
+func fromFloats(xs []float64) data.Token {
+	values := make([]data.Token, len(xs))
+	for i, x := range xs {
+		values[i] = data.NewDecimalToken(x)
+	}
+	return data.NewArrayToken(values)
+}
+
+// shapeOf returns the length of each axis of the value token, which is empty if it is not an Array
+func shapeOf(value data.Token) []int {
+	var shape []int
+	for a, ok := value.(data.Array); ok; a, ok = a.Value()[0].(data.Array) {
+		shape = append(shape, len(a.Value()))
+	}
+	return shape
+}
+
+// equalShapes returns true if both shapes have the same lengths, otherwise returns false
+func equalShapes(s1, s2 []int) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+
+	for i := range s1 {
+		if s1[i] != s2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// canBroadcast returns true if the shape with fewer axes is the end of the other one,
+// otherwise returns false
+//
+//	2x3 and 3 => true
+//	2x3 and 2 => false
+func canBroadcast(s1, s2 []int) bool {
+	if len(s1) < len(s2) {
+		s1, s2 = s2, s1
+	}
+	return equalShapes(s1[len(s1)-len(s2):], s2)
+}
+
+// formatShape returns the lengths of the shape separated by an 'x', or scalar if it has no axes
+//
+//	3, 2x3, scalar
+func formatShape(shape []int) string {
+	if len(shape) == 0 {
+		return "scalar"
+	}
+
+	lengths := make([]string, len(shape))
+	for i, n := range shape {
+		lengths[i] = strconv.Itoa(n)
+	}
+	return strings.Join(lengths, "x")
+}
+
+// formatArray returns the elements of the array between brackets
+//
+//	[1, 2, 3], [[1, 2], [3, 4]]
+func formatArray(a data.Array) string {
+	values := make([]string, len(a.Value()))
+	for i, e := range a.Value() {
+		values[i] = Format(e)
+	}
+	return string(data.LeftBracket) + strings.Join(values, ", ") + string(data.RightBracket)
+}
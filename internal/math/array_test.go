@@ -0,0 +1,110 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+// vector returns the numbers as a token Array
+func vector(xs ...float64) data.Token {
+	return fromFloats(xs)
+}
+
+// matrix returns the rows as a token Array
+func matrix(rows ...data.Token) data.Token {
+	return data.NewArrayToken(rows)
+}
+
+func TestOperateArray(t *testing.T) {
+	a := matrix(vector(1, 2), vector(3, 4))
+
+	value, err := operateArray(data.AddToken, a, vector(10, 20), Options{})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "[[11, 22], [13, 24]]", Format(value))
+
+	value, err = operateArray(data.SubToken, data.NewDecimalToken(0), vector(1, 2), Options{})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "[-1, -2]", Format(value))
+
+	value, err = operateArray(data.ProductToken, vector(1, 2, 3), vector(4, 5, 6), Options{})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, data.NewDecimalToken(32), value)
+
+	value, err = operateArray(data.ProductToken, vector(1, 1), a, Options{})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "[4, 6]", Format(value))
+
+	value, err = operateArray(data.ProductToken, a, a, Options{})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "[[7, 10], [15, 22]]", Format(value))
+
+	_, err = operateArray(data.AddToken, a, vector(1, 2, 3), Options{})
+	assert.True(t, ierr.As(err, ierr.CtxShapeMismatch), "error != CtxShapeMismatch")
+
+	_, err = operateArray(data.ProductToken, vector(1, 2), vector(1, 2, 3), Options{})
+	assert.True(t, ierr.As(err, ierr.CtxShapeMismatch), "error != CtxShapeMismatch")
+}
+
+func TestLinearAlgebra(t *testing.T) {
+	a := matrix(vector(4, 7), vector(2, 6))
+
+	value, err := det([]data.Token{a})
+	assert.Nil(t, err, "error != nil")
+	assert.InDelta(t, 10, value.(data.Decimal).Value(), 1e-12)
+
+	value, err = det([]data.Token{matrix(vector(0, 1), vector(1, 0))})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, data.NewDecimalToken(-1), value)
+
+	value, err = inv([]data.Token{a})
+	assert.Nil(t, err, "error != nil")
+
+	value, err = product(a, value, Options{})
+	assert.Nil(t, err, "error != nil")
+	for i, row := range value.(data.Array).Value() {
+		for j, e := range row.(data.Array).Value() {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			assert.InDelta(t, want, e.(data.Decimal).Value(), 1e-12)
+		}
+	}
+
+	for _, singular := range []data.Token{
+		matrix(vector(1, 2), vector(2, 4)),
+		matrix(vector(0.1, 0.2), vector(0.3, 0.6)),
+		matrix(vector(1, 2, 3), vector(4, 5, 6), vector(7, 8, 9)),
+	} {
+		_, err = inv([]data.Token{singular})
+		assert.True(t, ierr.As(err, ierr.CtxSingularMatrix), "error != CtxSingularMatrix")
+	}
+
+	_, err = inv([]data.Token{vector(1, 2)})
+	assert.True(t, ierr.As(err, ierr.CtxShapeMismatch), "error != CtxShapeMismatch")
+
+	value, err = cross([]data.Token{vector(0, 1, 0), vector(0, 0, 1)})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "[1, 0, 0]", Format(value))
+
+	value, err = transposeOf([]data.Token{vector(1, 2)})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "[[1], [2]]", Format(value))
+
+	value, err = norm([]data.Token{matrix(vector(1, 2), vector(2, 4))})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, data.NewDecimalToken(5), value)
+
+	value, err = linsolve([]data.Token{matrix(vector(2, 1), vector(1, 3)), vector(3, 5)})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "[0.8, 1.4]", Format(value))
+
+	_, err = linsolve([]data.Token{matrix(vector(1, 2), vector(2, 4)), vector(1, 2)})
+	assert.True(t, ierr.As(err, ierr.CtxSingularMatrix), "error != CtxSingularMatrix")
+
+	_, err = linsolve([]data.Token{matrix(vector(1, 2), vector(3, 4)), vector(1, 2, 3)})
+	assert.True(t, ierr.As(err, ierr.CtxShapeMismatch), "error != CtxShapeMismatch")
+}
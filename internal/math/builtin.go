@@ -0,0 +1,247 @@
+package math
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// elementary represents a function of one argument over the real and the complex numbers
+// and the intervals, where slope is its derivative over the real numbers
+// and isReal is true if its result is always a real number
+type elementary struct {
+	real     func(x float64) float64
+	complex  func(z complex128) complex128
+	interval func(lo, hi float64) (float64, float64)
+	slope    func(x float64) float64
+	isReal   bool
+}
+
+// elementaries are the functions of one argument always available inside the list of tokens
+var elementaries = map[string]elementary{
+	"sqrt": {real: math.Sqrt, complex: cmplx.Sqrt, interval: sqrtInterval, slope: sqrtSlope},
+	"exp":  {real: math.Exp, complex: cmplx.Exp, interval: increasing(math.Exp), slope: math.Exp},
+	"ln":   {real: math.Log, complex: cmplx.Log, interval: increasing(math.Log), slope: lnSlope},
+	"log":  {real: math.Log10, complex: cmplx.Log10, interval: increasing(math.Log10), slope: logSlope},
+	"sin":  {real: math.Sin, complex: cmplx.Sin, interval: sinInterval, slope: math.Cos},
+	"cos":  {real: math.Cos, complex: cmplx.Cos, interval: cosInterval, slope: cosSlope},
+	"tan":  {real: math.Tan, complex: cmplx.Tan, interval: tanInterval, slope: tanSlope},
+	"asin": {real: math.Asin, complex: cmplx.Asin, interval: increasing(math.Asin), slope: asinSlope},
+	"acos": {real: math.Acos, complex: cmplx.Acos, interval: decreasing(math.Acos), slope: acosSlope},
+	"atan": {real: math.Atan, complex: cmplx.Atan, interval: increasing(math.Atan), slope: atanSlope},
+	"abs":  {real: math.Abs, complex: complexOf(cmplx.Abs), interval: absInterval, slope: absSlope, isReal: true},
+	"conj": {real: identity, complex: cmplx.Conj, interval: identityInterval, slope: one},
+	"arg":  {real: argOf, complex: complexOf(cmplx.Phase), interval: argInterval, slope: zero, isReal: true},
+	"re":   {real: identity, complex: complexOf(realOf), interval: identityInterval, slope: one, isReal: true},
+	"im":   {real: zero, complex: complexOf(imagOf), interval: zeroInterval, slope: zero, isReal: true},
+}
+
+// builtins are the functions always available inside the list of tokens,
+// their arities are in data.BuiltinMap
+var builtins = map[string]Func{
+	// statistics
+	"sum":        wrapAggregate(Sum),
+	"avg":        wrapAggregate(Avg),
+	"median":     wrapAggregate(Median),
+	"mode":       wrapAggregate(Mode),
+	"stddev":     wrapAggregate(StdDev),
+	"var":        wrapAggregate(Var),
+	"min":        wrapAggregate(Min),
+	"max":        wrapAggregate(Max),
+	"percentile": wrapReal(percentile),
+
+	// finance
+	"pmt":  wrapFinance(PMT),
+	"pv":   wrapFinance(PV),
+	"fv":   wrapFinance(FV),
+	"nper": wrapFinance(NPER),
+	"rate": wrapReal(rate),
+	"npv":  wrapReal(npv),
+	"irr":  wrapReal(irr),
+
+	// linear algebra
+	"dot":       dot,
+	"cross":     cross,
+	"transpose": transposeOf,
+	"det":       det,
+	"inv":       inv,
+	"norm":      norm,
+	"linsolve":  linsolve,
+	"roots":     roots,
+}
+
+// complexBuiltins are the functions that replace the builtin ones in the complex mode
+var complexBuiltins = map[string]Func{}
+
+func init() {
+	for name, fn := range elementaries {
+		builtins[name] = wrapElementary(fn, false)
+		complexBuiltins[name] = wrapElementary(fn, true)
+	}
+}
+
+// !Tool Functions
+
+// wrapReal returns a function over real numbers as a Func
+func wrapReal(fn func(args []float64) (float64, error)) Func {
+	return func(args []data.Token) (data.Token, error) {
+		xs, err := toFloats(args)
+		if err != nil {
+			return nil, err
+		}
+
+		res64, err := fn(xs)
+		if err != nil {
+			return nil, err
+		}
+
+		return data.NewDecimalToken(res64), nil
+	}
+}
+
+// wrapAggregate returns an aggregate function as a Func
+func wrapAggregate(fn func(xs []float64) float64) Func {
+	return wrapReal(func(args []float64) (float64, error) {
+		return fn(args), nil
+	})
+}
+
+// wrapElementary returns an elementary function as a Func, where a real argument
+// whose real result is NaN gets a complex result in the complex mode, an Interval
+// gets the bounds of the function and an Array gets the function of each element
+func wrapElementary(fn elementary, isComplex bool) Func {
+	var call Func
+	call = func(args []data.Token) (data.Token, error) {
+		switch x := args[0].(type) {
+		case data.Decimal:
+			res64 := fn.real(x.Value())
+			if isComplex && math.IsNaN(res64) && !math.IsNaN(x.Value()) {
+				return data.NewComplexToken(fn.complex(complex(x.Value(), 0))), nil
+			}
+			return data.NewDecimalToken(res64), nil
+
+		case data.Complex:
+			z := fn.complex(x.Value())
+			if fn.isReal {
+				return data.NewDecimalToken(real(z)), nil
+			}
+			return data.NewComplexToken(z), nil
+
+		case data.Interval:
+			return data.NewIntervalToken(fn.interval(x.Lo(), x.Hi())), nil
+
+		case data.Uncertain:
+			return applyUncertain(fn.real, fn.slope, x), nil
+
+		case data.Array:
+			return mapArray(x, func(e data.Token) (data.Token, error) {
+				return call([]data.Token{e})
+			})
+		}
+
+		_, err := toFloat(args[0])
+		return nil, err
+	}
+	return call
+}
+
+// percentile calls Percentile with the first argument as p
+func percentile(args []float64) (float64, error) {
+	return Percentile(args[0], args[1:]), nil
+}
+
+// wrapFinance returns a function like fn(a, b, c, [d], [e]) as a Func,
+// where the optional arguments are zero by default
+func wrapFinance(fn func(a, b, c, d, e float64) float64) Func {
+	return wrapReal(func(args []float64) (float64, error) {
+		a := optionalArgs(args, 5)
+		return fn(a[0], a[1], a[2], a[3], a[4]), nil
+	})
+}
+
+// rate calls RATE with the guess 0.1 by default
+func rate(args []float64) (float64, error) {
+	a := optionalArgs(args, 6)
+	if len(args) < 6 {
+		a[5] = 0.1
+	}
+	return RATE(a[0], a[1], a[2], a[3], a[4], a[5])
+}
+
+// npv calls NPV with the first argument as the rate
+func npv(args []float64) (float64, error) {
+	return NPV(args[0], args[1:]), nil
+}
+
+// irr calls IRR with the guess 0.1
+func irr(args []float64) (float64, error) {
+	return IRR(args, 0.1)
+}
+
+// optionalArgs returns the arguments completed with zeros up to n
+func optionalArgs(args []float64, n int) []float64 {
+	a := make([]float64, n)
+	copy(a, args)
+	return a
+}
+
+// complexOf returns a real function of a complex number as a complex function
+func complexOf(fn func(z complex128) float64) func(z complex128) complex128 {
+	return func(z complex128) complex128 {
+		return complex(fn(z), 0)
+	}
+}
+
+// identity returns x
+func identity(x float64) float64 { return x }
+
+// zero returns 0
+func zero(float64) float64 { return 0 }
+
+// one returns 1
+func one(float64) float64 { return 1 }
+
+// sqrtSlope returns the derivative of √x
+func sqrtSlope(x float64) float64 { return 1 / (2 * math.Sqrt(x)) }
+
+// lnSlope returns the derivative of ln(x)
+func lnSlope(x float64) float64 { return 1 / x }
+
+// logSlope returns the derivative of log(x)
+func logSlope(x float64) float64 { return 1 / (x * math.Ln10) }
+
+// cosSlope returns the derivative of cos(x)
+func cosSlope(x float64) float64 { return -math.Sin(x) }
+
+// tanSlope returns the derivative of tan(x)
+func tanSlope(x float64) float64 { return 1 + math.Tan(x)*math.Tan(x) }
+
+// asinSlope returns the derivative of asin(x)
+func asinSlope(x float64) float64 { return 1 / math.Sqrt(1-x*x) }
+
+// acosSlope returns the derivative of acos(x)
+func acosSlope(x float64) float64 { return -1 / math.Sqrt(1-x*x) }
+
+// atanSlope returns the derivative of atan(x)
+func atanSlope(x float64) float64 { return 1 / (1 + x*x) }
+
+// absSlope returns the derivative of |x|, which is 0 at 0
+func absSlope(x float64) float64 {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+// argOf returns the argument of a real number
+func argOf(x float64) float64 { return math.Atan2(0, x) }
+
+// realOf returns the real part of z
+func realOf(z complex128) float64 { return real(z) }
+
+// imagOf returns the imaginary part of z
+func imagOf(z complex128) float64 { return imag(z) }
@@ -0,0 +1,85 @@
+package math
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// maxIntegerPower is the largest integer exponent of a complex power done by multiplications
+const maxIntegerPower = 64
+
+// operateComplex returns the value token of operating x and y with the operator of the given kind and nil,
+// otherwise returns nil and an error if the operation is not defined for complex numbers
+func operateComplex(kind data.TokenKind, x, y complex128) (data.Token, error) {
+	switch kind {
+	case data.AddToken:
+		return data.NewComplexToken(x + y), nil
+	case data.SubToken:
+		return data.NewComplexToken(x - y), nil
+	case data.MulToken:
+		return data.NewComplexToken(x * y), nil
+	case data.DivToken:
+		return data.NewComplexToken(x / y), nil
+	case data.PowToken:
+		return data.NewComplexToken(powComplex(x, y)), nil
+	}
+
+	return nil, ierr.ValueOperation(data.RuneMap[kind])
+}
+
+// powComplex returns x**y, doing it by multiplications if y is a small integer
+// so that powers like i^2 are exact
+func powComplex(x, y complex128) complex128 {
+	n := real(y)
+	if imag(y) != 0 || n != math.Trunc(n) || math.Abs(n) > maxIntegerPower {
+		return cmplx.Pow(x, y)
+	}
+
+	res, base := complex(1, 0), x
+	for k := int(math.Abs(n)); k > 0; k >>= 1 {
+		if k&1 == 1 {
+			res *= base
+		}
+		base *= base
+	}
+
+	if n < 0 {
+		return 1 / res
+	}
+	return res
+}
+
+// toComplex returns the value token as a complex128 and true, otherwise returns false
+func toComplex(value data.Token) (complex128, bool) {
+	switch value := value.(type) {
+	case data.Decimal:
+		return complex(value.Value(), 0), true
+	case data.Complex:
+		return value.Value(), true
+	}
+	return 0, false
+}
+
+// formatComplex returns z as a string like a+bi
+//
+//	1.5, 2i, 1-2i
+func formatComplex(z complex128) string {
+	re, im := real(z), imag(z)
+
+	if im == 0 {
+		return formatFloat(re)
+	}
+
+	if re == 0 {
+		return formatFloat(im) + "i"
+	}
+
+	if im < 0 || math.IsNaN(im) {
+		return formatFloat(re) + formatFloat(im) + "i"
+	}
+
+	return formatFloat(re) + "+" + formatFloat(im) + "i"
+}
@@ -0,0 +1,31 @@
+package math
+
+import (
+	"math/cmplx"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPowComplex(t *testing.T) {
+	assert.Equal(t, complex(-1, 0), powComplex(1i, 2))
+	assert.Equal(t, complex(0, -1), powComplex(1i, -1))
+	assert.Equal(t, complex(-4, 0), powComplex(1+1i, 4))
+	assert.Equal(t, cmplx.Pow(1i, 0.5), powComplex(1i, 0.5))
+}
+
+func TestFormatComplex(t *testing.T) {
+	assert.Equal(t, "1.5", formatComplex(1.5))
+	assert.Equal(t, "2i", formatComplex(2i))
+	assert.Equal(t, "1+2i", formatComplex(1+2i))
+	assert.Equal(t, "1-0.5i", formatComplex(1-0.5i))
+}
+
+func TestMathComplex(t *testing.T) {
+	_, err := Math(toList("√-4"), nil, Options{Complex: true})
+	assert.NotNil(t, err, "error == nil")
+
+	result, err := Math(toList("√-4 * √-4 + i^2"), nil, Options{Complex: true})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, -5.0, result)
+}
@@ -0,0 +1,178 @@
+package math
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// dateLayout is the layout of a date without a time, which gets one if an operation sets it
+const dateLayout = "2006-01-02"
+
+// operateDate returns the value token of operating x and y, where at least one of them
+// is a Moment or a Span, and nil, otherwise returns nil and an error if they cannot be operated together:
+//
+//	2026-10-18 + 90 days = 2027-01-16
+//	17:45 - 09:10 = 8h35m
+//	8h35m * 5 = 1d18h55m
+//	2026-10-18 + 2026-10-18 => error
+func operateDate(kind data.TokenKind, x, y data.Token) (data.Token, error) {
+	mx, isMomentX := x.(data.Moment)
+	my, isMomentY := y.(data.Moment)
+	sx, isSpanX := x.(data.Span)
+	sy, isSpanY := y.(data.Span)
+	dx, isNumX := x.(data.Decimal)
+	dy, isNumY := y.(data.Decimal)
+
+	// a plain zero like the one of -3 days => 0-(3*days) is a zero duration
+	if isNumX && dx.Value() == 0 && isSpanY {
+		sx, isSpanX, isNumX = data.Span{}, true, false
+	}
+
+	switch {
+	case isMomentX && isMomentY:
+		if kind == data.SubToken && hasDate(mx) == hasDate(my) {
+			return data.NewSpanToken(mx.Value().Sub(my.Value())), nil
+		}
+
+	case isMomentX && isSpanY:
+		switch kind {
+		case data.AddToken:
+			return newMoment(mx.Value().Add(sy.Value()), mx.Layout()), nil
+		case data.SubToken:
+			return newMoment(mx.Value().Add(-sy.Value()), mx.Layout()), nil
+		}
+
+	case isSpanX && isMomentY:
+		if kind == data.AddToken {
+			return newMoment(my.Value().Add(sx.Value()), my.Layout()), nil
+		}
+
+	case isSpanX && isSpanY:
+		switch kind {
+		case data.AddToken:
+			return data.NewSpanToken(sx.Value() + sy.Value()), nil
+		case data.SubToken:
+			return data.NewSpanToken(sx.Value() - sy.Value()), nil
+		case data.ModToken:
+			if sy.Value() == 0 {
+				return nil, ierr.IsNaN
+			}
+			return data.NewSpanToken(sx.Value() % sy.Value()), nil
+		case data.DivToken:
+			return data.NewDecimalToken(float64(sx.Value()) / float64(sy.Value())), nil
+		}
+
+	case isSpanX && isNumY:
+		switch kind {
+		case data.MulToken:
+			return newSpan(float64(sx.Value()) * dy.Value())
+		case data.DivToken:
+			return newSpan(float64(sx.Value()) / dy.Value())
+		}
+
+	case isNumX && isSpanY:
+		if kind == data.MulToken {
+			return newSpan(dx.Value() * float64(sy.Value()))
+		}
+	}
+
+	return nil, ierr.KindNotOperable(valueRune(x), data.RuneMap[kind], valueRune(y))
+}
+
+// !Tool Functions
+
+// newMoment returns a token Moment, whose layout shows the time if it is not midnight
+//
+//	2026-10-18 + 3 h = 2026-10-18T03:00
+func newMoment(t time.Time, layout string) data.Token {
+	if layout == dateLayout && t.Hour()+t.Minute()+t.Second()+t.Nanosecond() != 0 {
+		layout = data.DateLayouts[1]
+		if t.Second() != 0 {
+			layout = data.DateLayouts[2]
+		}
+	}
+	return data.NewMomentToken(t, layout)
+}
+
+// newSpan returns a token Span of the given nanoseconds and nil,
+// otherwise returns nil and an error if they are not a finite duration
+func newSpan(ns float64) (data.Token, error) {
+	if math.IsNaN(ns) {
+		return nil, ierr.IsNaN
+	}
+
+	if math.Abs(ns) > math.MaxInt64 {
+		return nil, ierr.IsInf
+	}
+
+	return data.NewSpanToken(time.Duration(math.Round(ns))), nil
+}
+
+// hasDate returns true if the moment has a date, otherwise returns false if it is only a time
+func hasDate(m data.Moment) bool {
+	return strings.HasPrefix(m.Layout(), dateLayout)
+}
+
+// isDate returns true if the value token is a Moment or a Span, otherwise returns false
+func isDate(value data.Token) bool {
+	switch value.(type) {
+	case data.Moment, data.Span:
+		return true
+	}
+	return false
+}
+
+// valueRune returns the rune that represents the type of the value token
+//
+//	n, u, ¤, d, t
+func valueRune(value data.Token) rune {
+	switch value.(type) {
+	case data.Quantity:
+		return data.Measure
+	case data.Money:
+		return data.Currency
+	case data.Moment:
+		return data.Date
+	case data.Span:
+		return data.Duration
+	}
+	return data.Num
+}
+
+// formatDuration returns the duration in days, hours, minutes and seconds without the zero ones
+//
+//	90d, 1d18h55m, 8h35m, 1.5s, 0s
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	var str strings.Builder
+	if d < 0 {
+		str.WriteRune(data.Sub)
+		d = -d
+	}
+
+	for _, part := range []struct {
+		unit   time.Duration
+		symbol string
+	}{
+		{24 * time.Hour, "d"}, {time.Hour, "h"}, {time.Minute, "m"},
+	} {
+		if n := d / part.unit; n != 0 {
+			str.WriteString(strconv.FormatInt(int64(n), 10) + part.symbol)
+			d -= n * part.unit
+		}
+	}
+
+	if d != 0 {
+		str.WriteString(formatFloat(d.Seconds()) + "s")
+	}
+
+	return str.String()
+}
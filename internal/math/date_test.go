@@ -0,0 +1,55 @@
+package math
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperateDate(t *testing.T) {
+	date := data.NewMomentToken(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "2006-01-02")
+	clock := data.NewMomentToken(time.Date(0, 1, 1, 17, 45, 0, 0, time.UTC), "15:04")
+	day := data.NewSpanToken(24 * time.Hour)
+
+	value, err := operateDate(data.AddToken, date, day)
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "2026-10-19", Format(value))
+
+	value, err = operateDate(data.SubToken, date, data.NewSpanToken(90*time.Minute))
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "2026-10-17T22:30", Format(value))
+
+	value, err = operateDate(data.MulToken, data.NewDecimalToken(2.5), day)
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "2d12h", Format(value))
+
+	value, err = operateDate(data.SubToken, data.NewDecimalToken(0), day)
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "-1d", Format(value))
+
+	_, err = operateDate(data.SubToken, clock, date)
+	assert.True(t, ierr.As(err, ierr.CtxKindNotOperable), "error != CtxKindNotOperable")
+
+	_, err = operateDate(data.DivToken, day, data.NewDecimalToken(0))
+	assert.Equal(t, ierr.IsInf, err)
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		give time.Duration
+		want string
+	}{
+		{give: 0, want: "0s"},
+		{give: 90 * 24 * time.Hour, want: "90d"},
+		{give: 42*time.Hour + 55*time.Minute, want: "1d18h55m"},
+		{give: 1500 * time.Millisecond, want: "1.5s"},
+		{give: -(8*time.Hour + 35*time.Minute), want: "-8h35m"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatDuration(tt.give))
+	}
+}
@@ -0,0 +1,103 @@
+package math
+
+import (
+	"math"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+)
+
+// zeroRate is the size of a rate below which the annuity formulas are replaced by their limit,
+// since they divide by the rate
+const zeroRate = 1e-6
+
+// The functions follow the spreadsheet conventions: money paid out is negative,
+// money received is positive, and typ is 1 if the payments are due at the
+// beginning of each period, otherwise they are due at the end.
+
+// FV returns the future value of an investment with periodic constant payments
+func FV(rate, nper, pmt, pv, typ float64) float64 {
+	if rate == 0 {
+		return -(pv + pmt*nper)
+	}
+
+	growth := math.Pow(1+rate, nper)
+	return -(pv*growth + pmt*(1+rate*typ)*(growth-1)/rate)
+}
+
+// PV returns the present value of an investment with periodic constant payments
+func PV(rate, nper, pmt, fv, typ float64) float64 {
+	if rate == 0 {
+		return -(fv + pmt*nper)
+	}
+
+	growth := math.Pow(1+rate, nper)
+	return -(fv + pmt*(1+rate*typ)*(growth-1)/rate) / growth
+}
+
+// PMT returns the periodic constant payment of a loan
+func PMT(rate, nper, pv, fv, typ float64) float64 {
+	if rate == 0 {
+		return -(fv + pv) / nper
+	}
+
+	growth := math.Pow(1+rate, nper)
+	return -(fv + pv*growth) * rate / ((1 + rate*typ) * (growth - 1))
+}
+
+// NPER returns the number of periods of an investment with periodic constant payments
+func NPER(rate, pmt, pv, fv, typ float64) float64 {
+	if rate == 0 {
+		return -(fv + pv) / pmt
+	}
+
+	due := pmt * (1 + rate*typ)
+	return math.Log((due-fv*rate)/(due+pv*rate)) / math.Log(1+rate)
+}
+
+// NPV returns the net present value of the cash flows at the end of each period
+func NPV(rate float64, values []float64) float64 {
+	var sum compensated
+	for i, value := range values {
+		sum.add(value / math.Pow(1+rate, float64(i+1)))
+	}
+	return sum.result()
+}
+
+// RATE returns the interest rate per period of an annuity and nil,
+// otherwise returns NaN and an error if the solver does not converge
+func RATE(nper, pmt, pv, fv, typ, guess float64) (float64, error) {
+	res64, ok, _ := newtonRaphson(infallible(func(rate float64) float64 {
+		if math.Abs(rate) < zeroRate {
+			// the first two terms of the series around a zero rate
+			return fv + pv + pmt*nper + rate*(pv*nper+pmt*(typ*nper+nper*(nper-1)/2))
+		}
+		return fv - FV(rate, nper, pmt, pv, typ)
+	}), guess, tolerance, maxIterations)
+
+	if !ok {
+		return math.NaN(), ierr.NoConvergence("rate")
+	}
+	return res64, nil
+}
+
+// IRR returns the internal rate of return of the cash flows, where the first
+// one is at the beginning, and nil, otherwise returns NaN and an error if the solver does not converge
+func IRR(values []float64, guess float64) (float64, error) {
+	res64, ok, _ := newtonRaphson(infallible(func(rate float64) float64 {
+		return NPV(rate, values) * (1 + rate)
+	}), guess, tolerance, maxIterations)
+
+	if !ok {
+		return math.NaN(), ierr.NoConvergence("irr")
+	}
+	return res64, nil
+}
+
+// !Tool Functions
+
+// infallible returns f as a function for the solvers that never fails
+func infallible(f func(x float64) float64) func(x float64) (float64, error) {
+	return func(x float64) (float64, error) {
+		return f(x), nil
+	}
+}
@@ -0,0 +1,55 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinance(t *testing.T) {
+	// The expected values are the ones of a spreadsheet
+	assert.InDelta(t, -1037.03, PMT(0.08/12, 10, 10000, 0, 0), 0.005)
+	assert.InDelta(t, -129.08, PMT(0.06/12, 18*12, 0, 50000, 0), 0.005)
+	assert.InDelta(t, -59777.15, PV(0.08/12, 12*20, 500, 0, 0), 0.005)
+	assert.InDelta(t, 2581.40, FV(0.06/12, 10, -200, -500, 1), 0.005)
+	assert.InDelta(t, 59.6738657, NPER(0.12/12, -100, -1000, 10000, 1), 1e-7)
+	assert.InDelta(t, 1188.44, NPV(0.1, []float64{-10000, 3000, 4200, 6800}), 0.005)
+
+	rate, err := RATE(4*12, -200, 8000, 0, 0, 0.1)
+	assert.Nil(t, err, "error != nil")
+	assert.InDelta(t, 0.0077014725, rate, 1e-10)
+
+	irr, err := IRR([]float64{-70000, 12000, 15000, 18000, 21000, 26000}, 0.1)
+	assert.Nil(t, err, "error != nil")
+	assert.InDelta(t, 0.0866309480, irr, 1e-10)
+
+	t.Run("Zero rate", func(t *testing.T) {
+		assert.Equal(t, -100.0, PMT(0, 10, 1000, 0, 0))
+		assert.Equal(t, -1000.0, PV(0, 10, 100, 0, 0))
+		assert.Equal(t, 1000.0, FV(0, 10, -100, 0, 0))
+		assert.Equal(t, 10.0, NPER(0, -100, 1000, 0, 0))
+
+		rate, err := RATE(10, -100, 1000, 0, 0, 0.1)
+		assert.Nil(t, err, "error != nil")
+		assert.InDelta(t, 0, rate, 1e-12)
+
+		rate, err = RATE(12, -100, 1000, 200, 1, 0.1)
+		assert.Nil(t, err, "error != nil")
+		assert.InDelta(t, 0, rate, 1e-12)
+	})
+
+	t.Run("No convergence", func(t *testing.T) {
+		_, err := IRR([]float64{100, 200, 300}, 0.1)
+		assert.True(t, ierr.As(err, ierr.CtxNoConvergence), "error != CtxNoConvergence")
+	})
+}
+
+func TestMathFinance(t *testing.T) {
+	result, err := Math(toList("pmt(0.08/12, 10, 10000) * 10 + fv(0, 10, -100)"), nil, Options{})
+	assert.Nil(t, err, "error != nil")
+	assert.InDelta(t, -9370.32, result, 0.005)
+
+	_, err = Math(toList("irr(1, 2, 3)"), nil, Options{})
+	assert.True(t, ierr.As(err, ierr.CtxNoConvergence), "error != CtxNoConvergence")
+}
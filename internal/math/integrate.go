@@ -0,0 +1,146 @@
+package math
+
+import (
+	"math"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+)
+
+// maxSubdivisions is the limit of subintervals of the adaptive quadrature
+const maxSubdivisions = 1000
+
+// quadTolerance is the absolute and relative error for the adaptive quadrature to converge
+const quadTolerance = 1e-10
+
+// The nodes and weights of the 15-point Kronrod rule, whose odd nodes
+// are the ones of the embedded 7-point Gauss rule, from the middle to one end
+var (
+	kronrodNodes = [8]float64{
+		0.991455371120812639206854697526329,
+		0.949107912342758524526189684047851,
+		0.864864423359769072789712788640926,
+		0.741531185599394439863864773280788,
+		0.586087235467691130294144845693013,
+		0.405845151377397166906606412076961,
+		0.207784955007898467600689403773245,
+		0,
+	}
+	kronrodWeights = [8]float64{
+		0.022935322010529224963732008058970,
+		0.063092092629978553290700663189204,
+		0.104790010322250183839876322541518,
+		0.140653259715525918745189590510238,
+		0.169004726639267902826583426598550,
+		0.190350578064785409913256402421014,
+		0.204432940075298892414161999234649,
+		0.209482141084727828012999174891714,
+	}
+	gaussWeights = [4]float64{
+		0.129484966168869693270611432679082,
+		0.279705391489276667901467771423780,
+		0.381830050505118944950369775488975,
+		0.417959183673469387755102040816327,
+	}
+)
+
+// segment represents a subinterval with its integral and the estimate of its error
+type segment struct {
+	a, b, value, err float64
+}
+
+// Integrate returns the integral of f from a to b, the estimate of its absolute error and nil
+// using the global adaptive Gauss-Kronrod quadrature, which bisects the subinterval
+// with the greatest error until the total one is small enough,
+// otherwise returns an error if f fails, a bound is not finite or it does not converge
+func Integrate(f func(x float64) (float64, error), a, b float64) (value, errEst float64, err error) {
+	if math.IsInf(a, 0) || math.IsInf(b, 0) || math.IsNaN(a) || math.IsNaN(b) {
+		return math.NaN(), math.NaN(), ierr.NotReal("integrate")
+	}
+
+	first, err := gaussKronrod(f, a, b)
+	if err != nil {
+		return math.NaN(), math.NaN(), err
+	}
+
+	segments := []segment{first}
+	value, errEst = first.value, first.err
+
+	for errEst > math.Max(quadTolerance, quadTolerance*math.Abs(value)) {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return value, errEst, nil
+		}
+
+		if len(segments) == maxSubdivisions {
+			return math.NaN(), math.NaN(), ierr.NoConvergence("integrate")
+		}
+
+		worst := 0
+		for i, s := range segments {
+			if s.err > segments[worst].err {
+				worst = i
+			}
+		}
+
+		s := segments[worst]
+		mid := 0.5 * (s.a + s.b)
+
+		left, err := gaussKronrod(f, s.a, mid)
+		if err != nil {
+			return math.NaN(), math.NaN(), err
+		}
+
+		right, err := gaussKronrod(f, mid, s.b)
+		if err != nil {
+			return math.NaN(), math.NaN(), err
+		}
+
+		segments[worst] = left
+		segments = append(segments, right)
+
+		value, errEst = 0, 0
+		for _, s := range segments {
+			value += s.value
+			errEst += s.err
+		}
+	}
+
+	return value, errEst, nil
+}
+
+// !Tool Functions
+
+// gaussKronrod returns the integral of f from a to b by the 15-point Kronrod rule,
+// whose error is estimated by the difference with the embedded 7-point Gauss rule,
+// otherwise returns an error if f fails
+func gaussKronrod(f func(x float64) (float64, error), a, b float64) (segment, error) {
+	center, half := 0.5*(a+b), 0.5*(b-a)
+
+	fc, err := f(center)
+	if err != nil {
+		return segment{}, err
+	}
+
+	kronrod := kronrodWeights[7] * fc
+	gauss := gaussWeights[3] * fc
+
+	for j := 0; j < 7; j++ {
+		dx := half * kronrodNodes[j]
+
+		f1, err := f(center - dx)
+		if err != nil {
+			return segment{}, err
+		}
+
+		f2, err := f(center + dx)
+		if err != nil {
+			return segment{}, err
+		}
+
+		kronrod += kronrodWeights[j] * (f1 + f2)
+		if j%2 == 1 {
+			gauss += gaussWeights[j/2] * (f1 + f2)
+		}
+	}
+
+	return segment{a: a, b: b, value: kronrod * half, err: math.Abs((kronrod - gauss) * half)}, nil
+}
@@ -0,0 +1,42 @@
+package math
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrate(t *testing.T) {
+	tests := []struct {
+		f    func(x float64) (float64, error)
+		a, b float64
+		want float64
+	}{
+		{f: func(x float64) (float64, error) { return x * x, nil }, a: 0, b: 3, want: 9},
+		{f: func(x float64) (float64, error) { return math.Sin(x), nil }, a: 0, b: math.Pi, want: 2},
+		{f: func(x float64) (float64, error) { return math.Exp(-x * x), nil }, a: -10, b: 10, want: math.Sqrt(math.Pi)},
+		{f: func(x float64) (float64, error) { return 1 / math.Sqrt(x), nil }, a: 0, b: 1, want: 2},
+		{f: func(x float64) (float64, error) { return x, nil }, a: 1, b: -1, want: 0},
+		{f: func(x float64) (float64, error) { return 1, nil }, a: 2, b: 0, want: -2},
+	}
+
+	for _, tt := range tests {
+		got, errEst, err := Integrate(tt.f, tt.a, tt.b)
+		assert.Nil(t, err, "error != nil")
+		assert.InDelta(t, tt.want, got, 1e-8)
+		assert.Less(t, errEst, 1e-8)
+	}
+
+	_, _, err := Integrate(func(x float64) (float64, error) { return math.Sin(1 / x), nil }, 0, 1)
+	assert.True(t, ierr.As(err, ierr.CtxNoConvergence), "error != CtxNoConvergence")
+
+	_, _, err = Integrate(func(x float64) (float64, error) { return x, nil }, 0, math.Inf(1))
+	assert.True(t, ierr.As(err, ierr.CtxNotReal), "error != CtxNotReal")
+
+	bug := errors.New("bug")
+	_, _, err = Integrate(func(x float64) (float64, error) { return 0, bug }, 0, 1)
+	assert.ErrorIs(t, err, bug)
+}
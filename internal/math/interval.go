@@ -0,0 +1,381 @@
+package math
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// maxIntPower is the greatest integer exponent that is raised by repeated multiplications
+const maxIntPower = 1 << 31
+
+// operateInterval returns the interval of operating x and y, where at least one of them is an Interval
+// and a Decimal is an interval of one number, with each bound rounded outward, and nil,
+// otherwise returns nil and an error if the operation is not defined for them:
+//
+//	[1, 2] + [3, 4] = [4, 6]
+//	[-1, 2] ^ 2 = [0, 4]
+//	[1, 2] / [-1, 1] => error
+func operateInterval(kind data.TokenKind, x, y data.Token) (data.Token, error) {
+	ix, okX := toInterval(x)
+	iy, okY := toInterval(y)
+
+	if !okX || !okY {
+		return nil, ierr.ValueOperation(data.RuneMap[kind])
+	}
+
+	xl, xh, yl, yh := ix.Lo(), ix.Hi(), iy.Lo(), iy.Hi()
+
+	switch kind {
+	case data.AddToken:
+		lo, _ := addBounds(xl, yl)
+		_, hi := addBounds(xh, yh)
+		return data.NewIntervalToken(lo, hi), nil
+
+	case data.SubToken:
+		lo, _ := addBounds(xl, -yh)
+		_, hi := addBounds(xh, -yl)
+		return data.NewIntervalToken(lo, hi), nil
+
+	case data.MulToken:
+		return data.NewIntervalToken(corners(mulBounds, xl, xh, yl, yh)), nil
+
+	case data.DivToken:
+		if yl <= 0 && 0 <= yh {
+			return nil, ierr.IsInf
+		}
+		return data.NewIntervalToken(corners(divBounds, xl, xh, yl, yh)), nil
+
+	case data.ModToken:
+		return modInterval(ix, iy)
+	}
+
+	return powInterval(ix, iy)
+}
+
+// sqrtInterval returns the interval of the square root of the part of x that is not negative,
+// which is NaN if there is not such part
+func sqrtInterval(lo, hi float64) (float64, float64) {
+	if hi < 0 {
+		return math.NaN(), math.NaN()
+	}
+
+	lo, _ = sqrtBounds(math.Max(lo, 0))
+	_, hi = sqrtBounds(hi)
+	return lo, hi
+}
+
+// modInterval returns the interval of x mod y and nil, otherwise returns nil and an error
+// if y may be zero, where x mod y has the sign of x and is smaller than y
+//
+//	[5, 6] mod 4 = [1, 2]
+//	[7, 9] mod 4 = [0, 4]
+func modInterval(x, y data.Interval) (data.Token, error) {
+	if y.Lo() <= 0 && 0 <= y.Hi() {
+		return nil, ierr.IsNaN
+	}
+
+	lo, hi := corners(divBounds, x.Lo(), x.Hi(), y.Lo(), y.Hi())
+
+	// x mod y = x - k*y if the quotient truncates to k everywhere
+	if k := math.Trunc(lo); k == math.Trunc(hi) {
+		ky, _ := operateInterval(data.MulToken, data.NewDecimalToken(k), y)
+		return operateInterval(data.SubToken, x, ky)
+	}
+
+	m := math.Max(math.Abs(y.Lo()), math.Abs(y.Hi()))
+	lo, hi = math.Max(x.Lo(), -m), math.Min(x.Hi(), m)
+
+	if x.Lo() >= 0 {
+		lo = 0
+	}
+
+	if x.Hi() <= 0 {
+		hi = 0
+	}
+
+	return data.NewIntervalToken(lo, hi), nil
+}
+
+// powInterval returns the interval of x raised to y and nil, otherwise returns nil and an error
+// if it is not bounded, where an integer exponent accepts negative bases
+func powInterval(x, y data.Interval) (data.Token, error) {
+	xl, xh, yl, yh := x.Lo(), x.Hi(), y.Lo(), y.Hi()
+
+	if yl == yh && yl == math.Trunc(yl) && math.Abs(yl) <= maxIntPower {
+		return powIntInterval(x, int64(yl))
+	}
+
+	if xh < 0 {
+		return nil, ierr.IsNaN
+	}
+
+	lo, hi := corners(func(a, b float64) (float64, float64) {
+		return widen(math.Pow(a, b), math.Pow(a, b))
+	}, math.Max(xl, 0), xh, yl, yh)
+
+	return data.NewIntervalToken(lo, hi), nil
+}
+
+// powIntInterval returns the interval of x raised to n with repeated multiplications and nil,
+// otherwise returns nil and an error if n is negative and x may be zero
+func powIntInterval(x data.Interval, n int64) (data.Token, error) {
+	if n < 0 {
+		p, err := powIntInterval(x, -n)
+		if err != nil {
+			return nil, err
+		}
+		return operateInterval(data.DivToken, data.NewDecimalToken(1), p)
+	}
+
+	xl, xh := x.Lo(), x.Hi()
+	ll, lh := powIntBounds(xl, uint64(n))
+	hl, hh := powIntBounds(xh, uint64(n))
+
+	// an odd power is increasing
+	if n%2 == 1 {
+		return data.NewIntervalToken(ll, hh), nil
+	}
+
+	lo, hi := math.Min(ll, hl), math.Max(lh, hh)
+	if xl < 0 && 0 < xh && n != 0 {
+		lo = 0
+	}
+
+	return data.NewIntervalToken(lo, hi), nil
+}
+
+// literalInterval returns the tightest interval of float64 that contains the decimal number
+//
+//	0.5 => [0.5, 0.5]
+//	0.1 => [0.09999999999999999, 0.1]
+func literalInterval(num string) data.Token {
+	x, _ := strconv.ParseFloat(num, 64)
+
+	exact, ok := new(big.Rat).SetString(num)
+	if !ok || math.IsInf(x, 0) {
+		return data.NewIntervalToken(x, x)
+	}
+
+	near := new(big.Rat).SetFloat64(x)
+	return data.NewIntervalToken(bounds(x, float64(exact.Cmp(near))))
+}
+
+// piInterval returns the tightest interval of float64 that contains π
+func piInterval() data.Token {
+	return data.NewIntervalToken(math.Pi, math.Nextafter(math.Pi, math.Inf(1)))
+}
+
+// !Bounds of the elementary functions
+
+// increasing returns the bounds of an increasing function, widened for its rounding
+func increasing(fn func(x float64) float64) func(lo, hi float64) (float64, float64) {
+	return func(lo, hi float64) (float64, float64) {
+		lo, _ = widen(fn(lo), fn(lo))
+		_, hi = widen(fn(hi), fn(hi))
+		return lo, hi
+	}
+}
+
+// decreasing returns the bounds of a decreasing function, widened for its rounding
+func decreasing(fn func(x float64) float64) func(lo, hi float64) (float64, float64) {
+	return func(lo, hi float64) (float64, float64) {
+		return increasing(fn)(hi, lo)
+	}
+}
+
+// sinInterval returns the bounds of the sine
+func sinInterval(lo, hi float64) (float64, float64) {
+	return periodicInterval(math.Sin, lo, hi, math.Pi/2, -math.Pi/2)
+}
+
+// cosInterval returns the bounds of the cosine
+func cosInterval(lo, hi float64) (float64, float64) {
+	return periodicInterval(math.Cos, lo, hi, 0, math.Pi)
+}
+
+// tanInterval returns the bounds of the tangent, which are infinite if there is a pole between them
+func tanInterval(lo, hi float64) (float64, float64) {
+	if hasPeak(lo, hi, math.Pi/2, math.Pi) {
+		return math.Inf(-1), math.Inf(1)
+	}
+	return increasing(math.Tan)(lo, hi)
+}
+
+// absInterval returns the bounds of the absolute value
+func absInterval(lo, hi float64) (float64, float64) {
+	switch {
+	case lo >= 0:
+		return lo, hi
+	case hi <= 0:
+		return -hi, -lo
+	}
+	return 0, math.Max(-lo, hi)
+}
+
+// argInterval returns the bounds of the argument of a real number, which is 0 or π
+func argInterval(lo, hi float64) (float64, float64) {
+	pi := piInterval().(data.Interval)
+
+	switch {
+	case lo >= 0:
+		return 0, 0
+	case hi < 0:
+		return pi.Lo(), pi.Hi()
+	}
+	return 0, pi.Hi()
+}
+
+// identityInterval returns the same bounds
+func identityInterval(lo, hi float64) (float64, float64) { return lo, hi }
+
+// zeroInterval returns the bounds of 0
+func zeroInterval(float64, float64) (float64, float64) { return 0, 0 }
+
+// !Tool Functions
+
+// isInterval returns true if the value token is an Interval, otherwise returns false
+func isInterval(value data.Token) bool {
+	_, ok := value.(data.Interval)
+	return ok
+}
+
+// toInterval returns the value token as an Interval, where a Decimal is an interval of one number,
+// and true, otherwise returns false
+func toInterval(value data.Token) (data.Interval, bool) {
+	switch value := value.(type) {
+	case data.Interval:
+		return value, true
+	case data.Decimal:
+		return data.NewIntervalToken(value.Value(), value.Value()).(data.Interval), true
+	}
+	return data.Interval{}, false
+}
+
+// corners returns the least lower bound and the greatest upper bound of fn
+// at the four corners of the intervals [xl, xh] and [yl, yh]
+func corners(fn func(a, b float64) (float64, float64), xl, xh, yl, yh float64) (lo, hi float64) {
+	lo, hi = math.Inf(1), math.Inf(-1)
+
+	for _, a := range [2]float64{xl, xh} {
+		for _, b := range [2]float64{yl, yh} {
+			l, h := fn(a, b)
+			lo, hi = math.Min(lo, l), math.Max(hi, h)
+		}
+	}
+
+	return lo, hi
+}
+
+// periodicInterval returns the bounds of a function of period 2π, whose maximum is 1 at top
+// and whose minimum is -1 at bottom
+func periodicInterval(fn func(x float64) float64, lo, hi, top, bottom float64) (float64, float64) {
+	if hi-lo >= 2*math.Pi {
+		return -1, 1
+	}
+
+	l, _ := widen(math.Min(fn(lo), fn(hi)), 0)
+	_, h := widen(0, math.Max(fn(lo), fn(hi)))
+
+	if hasPeak(lo, hi, top, 2*math.Pi) {
+		h = 1
+	}
+
+	if hasPeak(lo, hi, bottom, 2*math.Pi) {
+		l = -1
+	}
+
+	return math.Max(l, -1), math.Min(h, 1)
+}
+
+// hasPeak returns true if [lo, hi] may contain c + k*period for an integer k,
+// where the check is loose enough to also accept the points next to its bounds
+func hasPeak(lo, hi, c, period float64) bool {
+	const slack = 1e-9
+
+	k := math.Ceil((lo-c)/period - slack)
+	return c+k*period <= hi+slack*math.Max(1, math.Abs(hi))
+}
+
+// powIntBounds returns the floats just below and above a raised to n by repeated squaring,
+// where a negative a has the sign of its power
+func powIntBounds(a float64, n uint64) (float64, float64) {
+	if a < 0 {
+		lo, hi := powIntBounds(-a, n)
+		if n%2 == 1 {
+			return -hi, -lo
+		}
+		return lo, hi
+	}
+
+	lo, hi := 1.0, 1.0
+	bl, bh := a, a
+
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			lo, _ = mulBounds(lo, bl)
+			_, hi = mulBounds(hi, bh)
+		}
+		bl, _ = mulBounds(bl, bl)
+		_, bh = mulBounds(bh, bh)
+	}
+
+	return lo, hi
+}
+
+// addBounds returns the floats just below and above the exact sum of a and b
+func addBounds(a, b float64) (float64, float64) {
+	s := a + b
+	bb := s - a
+	return bounds(s, (a-(s-bb))+(b-bb))
+}
+
+// mulBounds returns the floats just below and above the exact product of a and b
+func mulBounds(a, b float64) (float64, float64) {
+	p := a * b
+	return bounds(p, math.FMA(a, b, -p))
+}
+
+// divBounds returns the floats just below and above the exact quotient of a and b
+func divBounds(a, b float64) (float64, float64) {
+	q := a / b
+	r := math.FMA(-q, b, a)
+	if b < 0 {
+		r = -r
+	}
+	return bounds(q, r)
+}
+
+// sqrtBounds returns the floats just below and above the exact square root of a
+func sqrtBounds(a float64) (float64, float64) {
+	s := math.Sqrt(a)
+	return bounds(s, math.FMA(-s, s, a))
+}
+
+// bounds returns x and the next float64 in the direction of the sign of err,
+// which is the sign of the exact value minus x
+func bounds(x, err float64) (float64, float64) {
+	switch {
+	case err > 0:
+		return x, math.Nextafter(x, math.Inf(1))
+	case err < 0:
+		return math.Nextafter(x, math.Inf(-1)), x
+	}
+	return x, x
+}
+
+// widen returns the float64 before lo and the one after hi,
+// for the results of the functions that are not rounded to the nearest
+func widen(lo, hi float64) (float64, float64) {
+	return math.Nextafter(lo, math.Inf(-1)), math.Nextafter(hi, math.Inf(1))
+}
+
+// formatInterval returns the bounds of the interval between brackets
+//
+//	[0.09999999999999999, 0.1]
+func formatInterval(i data.Interval) string {
+	return string(data.LeftBracket) + formatFloat(i.Lo()) + ", " + formatFloat(i.Hi()) + string(data.RightBracket)
+}
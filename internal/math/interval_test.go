@@ -0,0 +1,88 @@
+package math
+
+import (
+	"math"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperateInterval(t *testing.T) {
+	interval := func(lo, hi float64) data.Token {
+		return data.NewIntervalToken(lo, hi)
+	}
+
+	tests := []struct {
+		kind data.TokenKind
+		x, y data.Token
+		want data.Token
+	}{
+		{kind: data.AddToken, x: interval(1, 2), y: interval(3, 4), want: interval(4, 6)},
+		{kind: data.SubToken, x: interval(1, 2), y: interval(3, 4), want: interval(-3, -1)},
+		{kind: data.MulToken, x: interval(-1, 2), y: interval(3, 4), want: interval(-4, 8)},
+		{kind: data.DivToken, x: interval(1, 2), y: interval(4, 8), want: interval(0.125, 0.5)},
+		{kind: data.PowToken, x: interval(-1, 2), y: data.NewDecimalToken(2), want: interval(0, 4)},
+		{kind: data.PowToken, x: interval(-2, -1), y: data.NewDecimalToken(3), want: interval(-8, -1)},
+		{kind: data.PowToken, x: interval(2, 4), y: data.NewDecimalToken(-1), want: interval(0.25, 0.5)},
+		{kind: data.ModToken, x: interval(5, 6), y: data.NewDecimalToken(4), want: interval(1, 2)},
+		{kind: data.ModToken, x: interval(7, 9), y: data.NewDecimalToken(4), want: interval(0, 4)},
+	}
+
+	for _, tt := range tests {
+		value, err := operateInterval(tt.kind, tt.x, tt.y)
+		assert.Nil(t, err, "error != nil")
+		assert.Equalf(t, tt.want, value, "%s %c %s", Format(tt.x), data.RuneMap[tt.kind], Format(tt.y))
+	}
+
+	value, err := operateInterval(data.AddToken, literalInterval("0.1"), literalInterval("0.2"))
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "[0.29999999999999993, 0.30000000000000004]", Format(value))
+
+	_, err = operateInterval(data.DivToken, interval(1, 2), interval(-1, 1))
+	assert.ErrorIs(t, err, ierr.IsInf)
+
+	_, err = operateInterval(data.PowToken, interval(-2, -1), data.NewDecimalToken(0.5))
+	assert.ErrorIs(t, err, ierr.IsNaN)
+
+	_, err = operateInterval(data.AddToken, interval(1, 2), data.NewComplexToken(1i))
+	assert.True(t, ierr.As(err, ierr.CtxValueOperation), "error != CtxValueOperation")
+}
+
+func TestLiteralInterval(t *testing.T) {
+	tests := []struct {
+		num    string
+		lo, hi float64
+	}{
+		{num: "0.5", lo: 0.5, hi: 0.5},
+		{num: "3", lo: 3, hi: 3},
+		{num: "0.1", lo: math.Nextafter(0.1, 0), hi: 0.1},
+		{num: "0.2", lo: math.Nextafter(0.2, 0), hi: 0.2},
+		{num: "0.3", lo: 0.3, hi: math.Nextafter(0.3, 1)},
+	}
+
+	for _, tt := range tests {
+		assert.Equalf(t, data.NewIntervalToken(tt.lo, tt.hi), literalInterval(tt.num), "%s", tt.num)
+	}
+}
+
+func TestElementaryInterval(t *testing.T) {
+	lo, hi := sqrtInterval(2, 2)
+	assert.Equal(t, math.Nextafter(lo, 2), hi)
+	assert.True(t, lo*lo < 2 && 2 < hi*hi, "√2 is not inside [%v, %v]", lo, hi)
+
+	lo, hi = sinInterval(0, math.Pi)
+	assert.Equal(t, 1.0, hi)
+	assert.LessOrEqual(t, lo, 0.0)
+
+	lo, hi = cosInterval(3, 4)
+	assert.Equal(t, -1.0, lo)
+	assert.Greater(t, hi, math.Cos(4))
+
+	lo, hi = tanInterval(1, 2)
+	assert.True(t, math.IsInf(lo, -1) && math.IsInf(hi, 1), "tan has no pole")
+
+	lo, hi = absInterval(-3, 2)
+	assert.Equal(t, [2]float64{0, 3}, [2]float64{lo, hi})
+}
@@ -2,6 +2,7 @@ package math
 
 import (
 	"math"
+	"math/big"
 	"strconv"
 
 	"github.com/brianlewyn/go-calculator/ierr"
@@ -9,15 +10,93 @@ import (
 	"github.com/brianlewyn/go-calculator/internal/doubly"
 )
 
-// Math returns the result of calculating the expression inside the list of tokens
-func Math(list *doubly.Doubly) (float64, error) {
+// Func represents a function that can be called inside the list of tokens,
+// whose arguments and result are value tokens like Decimal or Complex
+type Func func(args []data.Token) (data.Token, error)
+
+// Env resolves the variables and the functions found in the list of tokens
+type Env interface {
+	// Var returns the value token of the variable and true, otherwise returns false
+	Var(name string) (data.Token, bool)
+
+	// Func returns the function and true, otherwise returns false
+	Func(name string) (Func, bool)
+}
+
+// Options represents the settings to calculate the expression
+type Options struct {
+	// Compensated adds each additive run inside a pair of parentheses
+	// with the Kahan-Babuška-Neumaier summation instead of from left to right
+	Compensated bool
+
+	// Complex turns the imaginary unit i (or j) into a Complex, and the powers,
+	// roots and functions that are not real into a Complex instead of NaN
+	Complex bool
+
+	// Rates resolves the exchange rates to add, subtract and convert money
+	// of different currencies, and it can be nil
+	Rates Rates
+
+	// Interval turns each number and π into the tightest Interval that contains it,
+	// so the result is an Interval that contains the exact one
+	Interval bool
+
+	// Trace is called after each operation with the step done, and it can be nil
+	Trace func(step Step)
+
+	// Guard is called before each operation, whose error stops the calculation, and it can be nil
+	Guard func() error
+}
+
+// Math returns the result of calculating the expression inside the list of tokens,
+// where env resolves its variables and functions besides the builtin ones and it can be nil
+func Math(list *doubly.Doubly, env Env, opts Options) (float64, error) {
+	value, err := Evaluate(list, env, opts)
+	if err != nil {
+		return 0, err
+	}
+	return Float(value)
+}
+
+// Evaluate returns the value token of calculating the expression inside the list of tokens,
+// where env resolves its variables and functions besides the builtin ones and it can be nil
+func Evaluate(list *doubly.Doubly, env Env, opts Options) (data.Token, error) {
 	alwaysWrapInParentheses(list)
 
+	err := doBindings(list, env, opts)
+	if err != nil {
+		return nil, err
+	}
+
 	for {
 		left, right := obtainDeeperParentheses(list.Head())
 		if left != nil && right != nil {
-			fromNumberToDecimalFrom(left, right)
-			calculateExpression(list, left, right)
+			err := fromNumberToDecimalFrom(left, right, env, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			err = calculateExpression(list, left, right, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			if isFunction(left.Prev()) {
+				err = callFunction(list, left, right, env, opts)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			if isArrayLiteral(left.Prev()) {
+				err = doArray(list, left, right)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+
 			deleteParentheses(list, left, right)
 			continue
 		}
@@ -62,93 +141,491 @@ func searchFirstLeftTokenNodeWith(right *doubly.Node) *doubly.Node {
 // fromNumberToDecimalFrom converts the following:
 //   - the 'Number' nodes of the Tokenized Linked List to a 'Decimal'
 //   - the PiToken with math.Pi as a 'Float'
-func fromNumberToDecimalFrom(left, right *doubly.Node) {
+//   - the VarToken with its value in env, or the imaginary unit as a 'Complex' in the complex mode
+//   - the UnitToken with one of its unit as a 'Quantity', unless it is the target of a conversion
+//   - the CurrencyToken with one of its currency as a 'Money', unless it is the target of a conversion
+//   - the DateToken with its date or time as a 'Moment', and the DurationToken with its length as a 'Span'
+//   - the 'Number' nodes and the PiToken as an 'Interval' in the interval mode
+func fromNumberToDecimalFrom(left, right *doubly.Node, env Env, opts Options) error {
 	for temp := left.Next(); temp != right; temp = temp.Next() {
 
+		if isKind(temp, data.DateToken) {
+			t, layout, _ := data.ParseDate(temp.Token().(data.Word).Value())
+			temp.Update(data.NewMomentToken(t, layout))
+			continue
+		}
+
+		if isKind(temp, data.DurationToken) {
+			temp.Update(data.NewSpanToken(data.DurationMap[temp.Token().(data.Word).Value()]))
+			continue
+		}
+
+		if isKind(temp, data.CurrencyToken) && !isKind(temp.Prev(), data.ConvertToken) {
+			code := temp.Token().(data.Word).Value()
+			temp.Update(data.NewMoneyToken(big.NewRat(1, 1), code))
+			continue
+		}
+
+		if isKind(temp, data.UnitToken) && !isKind(temp.Prev(), data.ConvertToken) {
+			unit, _ := data.LookupUnit(temp.Token().(data.Word).Value())
+			temp.Update(data.NewQuantityToken(1, unit))
+			continue
+		}
+
+		if isKind(temp, data.PiToken) && opts.Interval {
+			temp.Update(piInterval())
+			continue
+		}
+
 		if isKind(temp, data.PiToken) {
 			temp.Update(data.NewDecimalToken(math.Pi))
 			continue
 		}
 
+		if isKind(temp, data.VarToken) {
+			name := temp.Token().(data.Word).Value()
+
+			if opts.Complex && data.IsImaginary(name) {
+				temp.Update(data.NewComplexToken(1i))
+				continue
+			}
+
+			if env == nil {
+				return ierr.NameUnknown(name)
+			}
+
+			value, ok := env.Var(name)
+			if !ok {
+				return ierr.NameUnknown(name)
+			}
+
+			temp.Update(value)
+			continue
+		}
+
+		if token, ok := temp.Token().(data.Number); ok && opts.Interval {
+			temp.Update(literalInterval(token.Value()))
+			continue
+		}
+
+		if token, ok := temp.Token().(data.Number); ok && token.IsUnary() {
+			temp.Update(data.NewZeroDecimalToken())
+			continue
+		}
+
 		if token, ok := temp.Token().(data.Number); ok {
 			decimal, _ := strconv.ParseFloat(token.Value(), 64)
 			temp.Update(data.NewDecimalToken(decimal))
 		}
 	}
+	return nil
 }
 
-// doPowAndRoot do powers & roots
-func doPowAndRoot(list *doubly.Doubly, left, right *doubly.Node) {
+// isFunction returns true if the node is a FuncToken, otherwise returns false
+func isFunction(node *doubly.Node) bool {
+	return node != nil && isKind(node, data.FuncToken)
+}
+
+// isArrayLiteral returns true if the node is an ArrayToken, otherwise returns false
+func isArrayLiteral(node *doubly.Node) bool {
+	return node != nil && isKind(node, data.ArrayToken)
+}
+
+// lookupFunc returns the function with the given name in env or in the builtin ones
+func lookupFunc(env Env, name string, opts Options) (Func, bool) {
+	if env != nil {
+		if fn, ok := env.Func(name); ok {
+			return fn, true
+		}
+	}
+
+	if opts.Complex {
+		if fn, ok := complexBuiltins[name]; ok {
+			return fn, true
+		}
+	}
+
+	fn, ok := builtins[name]
+	return fn, ok
+}
+
+// callFunction replaces the FuncToken before 'left' with the result of calling it
+// with the arguments between 'left' and 'right' and then deletes them
+func callFunction(list *doubly.Doubly, left, right *doubly.Node, env Env, opts Options) error {
+	node := left.Prev()
+	name := node.Token().(data.Word).Value()
+
+	fn, ok := lookupFunc(env, name, opts)
+	if !ok {
+		return ierr.NameUnknown(name)
+	}
+
+	err := guard(opts)
+	if err != nil {
+		return err
+	}
+
+	args := popArguments(list, left, right)
+
+	value, err := fn(args)
+	if err != nil {
+		return err
+	}
+
+	node.Update(value)
+	if opts.Trace != nil {
+		opts.Trace(Step{Op: name, Operands: args, Result: value, List: list})
+	}
+	return nil
+}
+
+// popArguments returns the value tokens between 'left' and 'right',
+// and then deletes them with both parentheses
+func popArguments(list *doubly.Doubly, left, right *doubly.Node) []data.Token {
+	var args []data.Token
 	for temp := left.Next(); temp != right; temp = temp.Next() {
+		if isKind(temp, data.NumToken) {
+			args = append(args, temp.Token())
+		}
+	}
 
-		if isKind(temp, data.PowToken) {
-			x, y := toDecimal(temp.Prev()), toDecimal(temp.Next())
-			temp.Update(data.NewDecimalToken(math.Pow(x, y)))
-			removeNodeEnds(list, temp)
+	for left.Next() != right {
+		list.RemoveNode(left.Next())
+	}
+	deleteParentheses(list, left, right)
+	return args
+}
+
+// doPercent turns each decimal, interval or uncertain value followed by a PercentToken into a percentage,
+// and returns true if there was any of them, otherwise returns an error
+// if a PercentToken follows another kind of value
+func doPercent(list *doubly.Doubly, left, right *doubly.Node) (bool, error) {
+	found := false
+
+	for temp := left.Next(); temp != right; temp = temp.Next() {
+		if !isKind(temp, data.PercentToken) {
 			continue
 		}
 
-		if isKind(temp, data.RootToken) {
-			sqrt := math.Sqrt(toDecimal(temp.Next()))
-			temp.Update(data.NewDecimalToken(sqrt))
-			list.RemoveNode(temp.Next())
+		if i, ok := temp.Prev().Token().(data.Interval); ok {
+			lo, _ := divBounds(i.Lo(), 100)
+			_, hi := divBounds(i.Hi(), 100)
+
+			temp.Update(data.NewPercentIntervalToken(lo, hi))
+			list.RemoveNode(temp.Prev())
+			found = true
+			continue
+		}
+
+		if u, ok := temp.Prev().Token().(data.Uncertain); ok {
+			temp.Update(data.NewPercentUncertainToken(scaleUncertain(u, 100)))
+			list.RemoveNode(temp.Prev())
+			found = true
+			continue
+		}
+
+		x, ok := toDecimal(temp.Prev())
+		if !ok {
+			return false, ierr.ValueOperation(data.Mod)
 		}
+
+		temp.Update(data.NewPercentToken(x / 100))
+		list.RemoveNode(temp.Prev())
+		found = true
 	}
+
+	return found, nil
 }
 
-// doMulDivAndMod do multiplication, division & module
-func doMulDivAndMod(list *doubly.Doubly, left, right *doubly.Node) {
+// doPercentToDecimal turns back the percentages left into decimals,
+// so a pair of parentheses ends a percentage
+func doPercentToDecimal(left, right *doubly.Node) {
 	for temp := left.Next(); temp != right; temp = temp.Next() {
+		if !isKind(temp, data.PercentToken) {
+			continue
+		}
 
-		if isKind(temp, data.MulToken) {
-			x, y := toDecimal(temp.Prev()), toDecimal(temp.Next())
-			temp.Update(data.NewDecimalToken(x * y))
-			removeNodeEnds(list, temp)
+		if i, ok := temp.Token().(data.Interval); ok {
+			temp.Update(data.NewIntervalToken(i.Lo(), i.Hi()))
 			continue
 		}
 
-		if isKind(temp, data.DivToken) {
-			x, y := toDecimal(temp.Prev()), toDecimal(temp.Next())
-			temp.Update(data.NewDecimalToken(x / y))
-			removeNodeEnds(list, temp)
+		if u, ok := temp.Token().(data.Uncertain); ok {
+			temp.Update(data.NewUncertainToken(u.Value(), u.Terms()))
 			continue
 		}
 
-		if isKind(temp, data.ModToken) {
-			x, y := toDecimal(temp.Prev()), toDecimal(temp.Next())
-			temp.Update(data.NewDecimalToken(math.Mod(x, y)))
-			removeNodeEnds(list, temp)
+		if x, ok := toDecimal(temp); ok {
+			temp.Update(data.NewDecimalToken(x))
+		}
+	}
+}
+
+// doPowAndRoot do powers & roots
+func doPowAndRoot(list *doubly.Doubly, left, right *doubly.Node, opts Options) error {
+	for temp := left.Next(); temp != right; temp = temp.Next() {
+
+		if isKind(temp, data.PowToken) {
+			// the square root of the exponent is done before the power, so 2^√3 is 2^(√3)
+			if isKind(temp.Next(), data.RootToken) {
+				err := doRoot(list, temp.Next(), opts)
+				if err != nil {
+					return err
+				}
+			}
+
+			err := doBinary(list, temp, opts)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if isKind(temp, data.RootToken) {
+			err := doRoot(list, temp, opts)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// doRoot replaces the root node and the next one with its square root,
+// where the square root of a square root is done first
+func doRoot(list *doubly.Doubly, node *doubly.Node, opts Options) error {
+	if isKind(node.Next(), data.RootToken) {
+		err := doRoot(list, node.Next(), opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	x := node.Next().Token()
+
+	err := guard(opts)
+	if err != nil {
+		return err
+	}
+
+	value, err := sqrt(x, opts)
+	if err != nil {
+		return err
+	}
+
+	node.Update(value)
+	list.RemoveNode(node.Next())
+
+	if opts.Trace != nil {
+		opts.Trace(Step{Op: string(data.Root), Operands: []data.Token{x}, Result: value, List: list})
+	}
+	return nil
+}
+
+// doPlusMinus do the standard uncertainties, which bind tighter than any operator
+// but powers & roots, so 2 * 9.81 ± 0.02 is twice the measurement
+func doPlusMinus(list *doubly.Doubly, left, right *doubly.Node, opts Options) error {
+	for temp := left.Next(); temp != right; temp = temp.Next() {
+		if isKind(temp, data.PlusMinusToken) {
+			err := doBinary(list, temp, Options{Trace: opts.Trace, Guard: opts.Guard})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// doMulDivAndMod do multiplication, division, module & dot product
+func doMulDivAndMod(list *doubly.Doubly, left, right *doubly.Node, opts Options) error {
+	for temp := left.Next(); temp != right; temp = temp.Next() {
+
+		switch temp.Token().Kind() {
+		case data.MulToken, data.DivToken, data.ModToken, data.ProductToken:
+			err := doBinary(list, temp, opts)
+			if err != nil {
+				return err
+			}
 		}
 	}
+	return nil
 }
 
-// doAddAndSub do addition & subtraction
-func doAddAndSub(list *doubly.Doubly, left, right *doubly.Node) {
+// doAddAndSub do addition & subtraction,
+// where adding or subtracting a percentage does it of the left operand, but a negation
+func doAddAndSub(list *doubly.Doubly, left, right *doubly.Node, opts Options) error {
 	for temp := left.Next(); temp != right; temp = temp.Next() {
 
-		if isKind(temp, data.AddToken) {
-			x, y := toDecimal(temp.Prev()), toDecimal(temp.Next())
-			temp.Update(data.NewDecimalToken(x + y))
-			removeNodeEnds(list, temp)
+		switch temp.Token().Kind() {
+		case data.AddToken, data.SubToken:
+			err := percentOf(temp.Prev(), temp.Next(), opts)
+			if err != nil {
+				return err
+			}
+
+			err = doBinary(list, temp, opts)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// doCompensatedAddAndSub do each run of additions & subtractions of decimals with the compensated summation,
+// leaving the runs with other values to doAddAndSub
+func doCompensatedAddAndSub(list *doubly.Doubly, left, right *doubly.Node, opts Options) error {
+	for temp := left.Next(); temp != right; temp = temp.Next() {
+		if !isDecimalRun(temp) || !isKind(temp.Next(), data.AddToken) && !isKind(temp.Next(), data.SubToken) {
 			continue
 		}
 
-		if isKind(temp, data.SubToken) {
-			x, y := toDecimal(temp.Prev()), toDecimal(temp.Next())
-			temp.Update(data.NewDecimalToken(x - y))
-			removeNodeEnds(list, temp)
+		err := guard(opts)
+		if err != nil {
+			return err
+		}
+
+		x, _ := toDecimal(temp)
+		unary := isUnary(temp)
+
+		var sum compensated
+		sum.add(x)
+
+		var terms []data.Token
+		if opts.Trace != nil {
+			terms = append(terms, temp.Token())
+		}
+
+		for op := temp.Next(); isKind(op, data.AddToken) || isKind(op, data.SubToken); op = temp.Next() {
+			y, ok := toDecimal(op.Next())
+			if !ok {
+				return ierr.ValueOperation(data.RuneMap[op.Token().Kind()])
+			}
+
+			if isKind(op.Next(), data.PercentToken) && !unary {
+				y *= sum.result()
+			}
+			unary = false
+
+			if isKind(op, data.AddToken) {
+				sum.add(y)
+			} else {
+				sum.add(-y)
+				y = -y
+			}
+
+			if opts.Trace != nil {
+				terms = append(terms, data.NewDecimalToken(y))
+			}
+
+			list.RemoveNode(op.Next())
+			list.RemoveNode(op)
+		}
+
+		temp.Update(data.NewDecimalToken(sum.result()))
+		if opts.Trace != nil {
+			opts.Trace(Step{Op: string(data.Add), Operands: terms, Result: temp.Token(), List: list})
 		}
 	}
+	return nil
+}
+
+// isDecimalRun returns true if the node starts a run of additions & subtractions
+// whose operands are all decimals, otherwise returns false
+func isDecimalRun(node *doubly.Node) bool {
+	if _, ok := node.Token().(data.Decimal); !ok {
+		return false
+	}
+
+	for op := node.Next(); isKind(op, data.AddToken) || isKind(op, data.SubToken); op = op.Next().Next() {
+		if _, ok := op.Next().Token().(data.Decimal); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// doBinary replaces the operator node with the result of operating its end nodes
+func doBinary(list *doubly.Doubly, node *doubly.Node, opts Options) error {
+	x, y := node.Prev().Token(), node.Next().Token()
+
+	kind := node.Token().Kind()
+
+	err := guard(opts)
+	if err != nil {
+		return err
+	}
+
+	value, err := operate(kind, x, y, opts)
+	if err != nil {
+		return err
+	}
+
+	node.Update(value)
+	removeNodeEnds(list, node)
+
+	if opts.Trace != nil {
+		opts.Trace(Step{Op: opName(kind), Operands: []data.Token{x, y}, Result: value, List: list})
+	}
+	return nil
+}
+
+// opName returns the name of the operator of the kind, which is its rune but the keyword mod
+func opName(kind data.TokenKind) string {
+	if kind == data.ModToken {
+		return "mod"
+	}
+	return string(data.RuneMap[kind])
 }
 
 // isKind returns true if the node's kind is equal to the given kind, otherwise returns false
 func isKind(node *doubly.Node, kind data.TokenKind) bool {
-	return node.Token().Kind() == kind
+	return node != nil && node.Token().Kind() == kind
+}
+
+// toDecimal returns node's value as type float64 and true if it is a Decimal, otherwise returns false
+func toDecimal(node *doubly.Node) (float64, bool) {
+	if node == nil {
+		return 0, false
+	}
+
+	value, ok := node.Token().(data.Decimal)
+	return value.Value(), ok
+}
+
+// percentOf updates the 'y' node with the percentage of the value of the 'x' node if it is a percentage,
+// unless 'x' is the zero of a negation, so -10% is -0.1
+func percentOf(x, y *doubly.Node, opts Options) error {
+	if !isKind(y, data.PercentToken) || isUnary(x) {
+		return nil
+	}
+
+	value, err := operate(data.MulToken, x.Token(), y.Token(), opts)
+	if err != nil {
+		return err
+	}
+
+	y.Update(value)
+	return nil
+}
+
+// isUnary returns true if the node is the zero written before a minus that is a negation, otherwise returns false
+func isUnary(node *doubly.Node) bool {
+	if node == nil {
+		return false
+	}
+
+	value, ok := node.Token().(data.Decimal)
+	return ok && value.IsUnary()
 }
 
-// toDecimal returns node's value as type float64
-func toDecimal(node *doubly.Node) float64 {
-	return node.Token().(data.Decimal).Value()
+// guard returns the error of the Guard option before an operation, otherwise returns nil
+func guard(opts Options) error {
+	if opts.Guard == nil {
+		return nil
+	}
+	return opts.Guard()
 }
 
 // removeNodeEnds RemoveNodes end nodes to current node
@@ -157,11 +634,67 @@ func removeNodeEnds(list *doubly.Doubly, node *doubly.Node) {
 	list.RemoveNode(node.Next())
 }
 
-// calculateExpression calculate an expression from a given range of nodes
-func calculateExpression(list *doubly.Doubly, left, right *doubly.Node) {
-	doPowAndRoot(list, left, right)
-	doMulDivAndMod(list, left, right)
-	doAddAndSub(list, left, right)
+// calculateExpression calculate an expression from a given range of nodes,
+// where everything before a conversion is calculated and converted before what follows it,
+// so 5 ft → m + 1 m is (5 ft → m) + 1 m
+func calculateExpression(list *doubly.Doubly, left, right *doubly.Node, opts Options) error {
+	for temp := left.Next(); temp != right; temp = temp.Next() {
+		if !isKind(temp, data.ConvertToken) {
+			continue
+		}
+
+		err := calculateOperations(list, left, temp, opts)
+		if err != nil {
+			return err
+		}
+
+		err = doConvert(list, temp, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return calculateOperations(list, left, right, opts)
+}
+
+// calculateOperations calculate the operations from a given range of nodes without a conversion
+func calculateOperations(list *doubly.Doubly, left, right *doubly.Node, opts Options) error {
+	percent, err := doPercent(list, left, right)
+	if err != nil {
+		return err
+	}
+
+	err = doPowAndRoot(list, left, right, opts)
+	if err != nil {
+		return err
+	}
+
+	err = doPlusMinus(list, left, right, opts)
+	if err != nil {
+		return err
+	}
+
+	err = doMulDivAndMod(list, left, right, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.Compensated {
+		err = doCompensatedAddAndSub(list, left, right, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = doAddAndSub(list, left, right, opts)
+	if err != nil {
+		return err
+	}
+
+	if percent {
+		doPercentToDecimal(left, right)
+	}
+	return nil
 }
 
 // deleteParentheses deletes the end nodes of the current node
@@ -170,16 +703,18 @@ func deleteParentheses(list *doubly.Doubly, left, right *doubly.Node) {
 	list.RemoveNode(right)
 }
 
-func result(head *doubly.Node) (float64, error) {
-	res64 := toDecimal(head)
+// result returns the value token left in the list and nil,
+// otherwise returns nil and an error if it is NaN or any type of infinity
+func result(head *doubly.Node) (data.Token, error) {
+	value := head.Token()
 
-	if math.IsNaN(res64) {
-		return 0, ierr.IsNaN
+	if isNaN(value) {
+		return nil, ierr.IsNaN
 	}
 
-	if math.IsInf(res64, 0) {
-		return 0, ierr.IsInf
+	if isInf(value) {
+		return nil, ierr.IsInf
 	}
 
-	return res64, nil
+	return value, nil
 }
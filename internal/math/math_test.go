@@ -2,15 +2,20 @@ package math
 
 import (
 	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/brianlewyn/go-calculator/internal/analyse"
 	"github.com/brianlewyn/go-calculator/internal/doubly"
 	"github.com/brianlewyn/go-calculator/internal/tokenize"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestMath(t *testing.T) {
-	result, err := Math(toList("(0.5 + 4.5 - 1) * 10 * √(6-2) / 4^2"))
+	result, err := Math(toList("(0.5 + 4.5 - 1) * 10 * √(6-2) / 4^2"), nil, Options{})
 	if err != nil {
 		t.Errorf("RESULT = %f\n", result)
 		t.Errorf("ERROR = %f\n", err)
@@ -18,6 +23,57 @@ func TestMath(t *testing.T) {
 	// t.Fatalf("RESULT = %0.2f\n", result) // RESULT = 5
 }
 
+func TestMathCompensated(t *testing.T) {
+	tests := []struct {
+		name  string
+		terms []string
+	}{
+		{
+			name:  "A long chain of tenths",
+			terms: strings.Split(strings.Repeat("+0.1", 1000)[1:], "+"),
+		},
+		{
+			name:  "Terms that cancel each other",
+			terms: []string{"100000000000000000000", "1", "-100000000000000000000", "0.000001"},
+		},
+		{
+			name:  "Alternating signs",
+			terms: strings.Split(strings.Repeat("+0.7+-0.3", 500)[1:], "+"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr := strings.ReplaceAll(strings.Join(tt.terms, "+"), "+-", "-")
+			want := referenceSum(tt.terms)
+
+			plain, err := Math(toList(expr), nil, Options{})
+			assert.Nil(t, err, "error != nil")
+
+			got, err := Math(toList("("+expr+")"), nil, Options{Compensated: true})
+			assert.Nil(t, err, "error != nil")
+
+			// The compensated error is bounded by one rounding of the result
+			bound := math.Abs(want) * 0x1p-53
+			assert.LessOrEqualf(t, math.Abs(got-want), bound, "got: %v, want: %v", got, want)
+			assert.LessOrEqualf(t, math.Abs(got-want), math.Abs(plain-want), "plain: %v", plain)
+		})
+	}
+}
+
+// referenceSum returns the exact sum of the float64 value of the terms rounded to a float64
+func referenceSum(terms []string) float64 {
+	sum := new(big.Float).SetPrec(2048)
+
+	for _, term := range terms {
+		x, _ := strconv.ParseFloat(term, 64)
+		sum.Add(sum, new(big.Float).SetFloat64(x))
+	}
+
+	res64, _ := sum.Float64()
+	return res64
+}
+
 // toList returns the expression in a raw Tokenized Linked List
 func toList(expression string) *doubly.Doubly {
 	list, err1 := tokenize.Tokenizer(expression)
@@ -36,6 +92,6 @@ func toList(expression string) *doubly.Doubly {
 // go test -bench=BenchmarkMath -benchmem -count=10 -benchtime=100x >> bench.txt
 func BenchmarkMath(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		Math(toList("(0.5 + 4.5 - 1) * 10 * √(6-2) / 4^2"))
+		Math(toList("(0.5 + 4.5 - 1) * 10 * √(6-2) / 4^2"), nil, Options{})
 	}
 }
@@ -0,0 +1,149 @@
+package math
+
+import (
+	"math/big"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// moneyDigits are the digits after the dot to show an amount
+const moneyDigits = 2
+
+// Rates resolves the exchange rates between the currencies found in the list of tokens
+type Rates interface {
+	// Rate returns how much of the 'to' currency is one of the 'from' currency and true,
+	// otherwise returns false
+	Rate(from, to string) (*big.Rat, bool)
+}
+
+// operateMoney returns the value token of operating x and y, where at least one of them
+// is a Money, and nil, otherwise returns nil and an error if the operation is not defined
+// for them, or there is no rate to add or subtract two currencies:
+//
+//	$12.50 + 3 EUR = 15.75 USD, where 1 EUR = 1.0833... USD
+//	$10 / 4 = 2.50 USD
+//	$10 / $4 = 2.5
+//	$10 * $4 => error
+func operateMoney(kind data.TokenKind, x, y data.Token, opts Options) (data.Token, error) {
+	mx, isMoneyX := x.(data.Money)
+	my, isMoneyY := y.(data.Money)
+
+	rx, okX := toRat(x)
+	ry, okY := toRat(y)
+
+	if !okX || !okY {
+		return nil, ierr.ValueOperation(data.RuneMap[kind])
+	}
+
+	switch {
+	case isMoneyX && isMoneyY:
+		ry, err := exchange(ry, my.Currency(), mx.Currency(), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		switch kind {
+		case data.AddToken:
+			return data.NewMoneyToken(ry.Add(rx, ry), mx.Currency()), nil
+		case data.SubToken:
+			return data.NewMoneyToken(ry.Sub(rx, ry), mx.Currency()), nil
+		case data.DivToken:
+			if ry.Sign() == 0 {
+				return nil, ierr.IsInf
+			}
+			res64, _ := ry.Quo(rx, ry).Float64()
+			return data.NewDecimalToken(res64), nil
+		}
+
+	case isMoneyX:
+		switch kind {
+		case data.MulToken:
+			return data.NewMoneyToken(ry.Mul(rx, ry), mx.Currency()), nil
+		case data.DivToken:
+			if ry.Sign() == 0 {
+				return nil, ierr.IsInf
+			}
+			return data.NewMoneyToken(ry.Quo(rx, ry), mx.Currency()), nil
+		}
+
+	default:
+		switch kind {
+		case data.MulToken:
+			return data.NewMoneyToken(rx.Mul(rx, ry), my.Currency()), nil
+		case data.AddToken, data.SubToken:
+			// a plain zero like the one of -$5 => 0-(5*USD) is an amount of any currency
+			if rx.Sign() == 0 {
+				return operateMoney(kind, data.NewMoneyToken(rx, my.Currency()), y, opts)
+			}
+		}
+	}
+
+	return nil, ierr.ValueOperation(data.RuneMap[kind])
+}
+
+// convertMoney returns the value token of the money in the given currency and nil,
+// otherwise returns nil and an error if it is not a Money or there is no rate
+//
+//	12 EUR → USD = 13.00 USD, where 1 EUR = 1.0833... USD
+func convertMoney(value data.Token, currency string, opts Options) (data.Token, error) {
+	money, ok := value.(data.Money)
+	if !ok {
+		return nil, ierr.ValueOperation(data.Convert)
+	}
+
+	amount, err := exchange(money.Value(), money.Currency(), currency, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.NewMoneyToken(amount, currency), nil
+}
+
+// !Tool Functions
+
+// exchange returns a new amount of the 'from' currency in the 'to' currency and nil,
+// otherwise returns nil and an error if there is no rate between them
+func exchange(amount *big.Rat, from, to string, opts Options) (*big.Rat, error) {
+	if from == to {
+		return new(big.Rat).Set(amount), nil
+	}
+
+	if opts.Rates == nil {
+		return nil, ierr.RateUnknown(from, to)
+	}
+
+	rate, ok := opts.Rates.Rate(from, to)
+	if !ok {
+		return nil, ierr.RateUnknown(from, to)
+	}
+
+	return new(big.Rat).Mul(amount, rate), nil
+}
+
+// toRat returns a new exact value of a Money or a Decimal and true, otherwise returns false,
+// where a Decimal is the shortest decimal that represents it
+//
+//	0.1 => 1/10
+func toRat(value data.Token) (*big.Rat, bool) {
+	switch value := value.(type) {
+	case data.Money:
+		return new(big.Rat).Set(value.Value()), true
+	case data.Decimal:
+		return new(big.Rat).SetString(formatFloat(value.Value()))
+	}
+	return nil, false
+}
+
+// isMoney returns true if the value token is a Money, otherwise returns false
+func isMoney(value data.Token) bool {
+	_, ok := value.(data.Money)
+	return ok
+}
+
+// formatMoney returns the amount rounded to the cents with its currency code
+//
+//	12.50 USD
+func formatMoney(m data.Money) string {
+	return m.Value().FloatString(moneyDigits) + string(data.Gap) + m.Currency()
+}
@@ -0,0 +1,49 @@
+package math
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+// rates is a table of exchange rates for the tests
+type rates map[[2]string]*big.Rat
+
+func (r rates) Rate(from, to string) (*big.Rat, bool) {
+	rate, ok := r[[2]string{from, to}]
+	return rate, ok
+}
+
+func TestOperateMoney(t *testing.T) {
+	usd := func(amount string) data.Token {
+		value, _ := new(big.Rat).SetString(amount)
+		return data.NewMoneyToken(value, "USD")
+	}
+	eur := data.NewMoneyToken(big.NewRat(10, 1), "EUR")
+	opts := Options{Rates: rates{{"EUR", "USD"}: big.NewRat(11, 10)}}
+
+	value, err := operateMoney(data.AddToken, usd("0.1"), usd("0.2"), opts)
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "3/10", value.(data.Money).Value().String())
+
+	value, err = operateMoney(data.MulToken, data.NewDecimalToken(0.1), usd("3"), opts)
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "3/10", value.(data.Money).Value().String())
+
+	value, err = operateMoney(data.SubToken, usd("12"), eur, opts)
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "1.00 USD", Format(value))
+
+	_, err = operateMoney(data.SubToken, eur, usd("12"), opts)
+	assert.True(t, ierr.As(err, ierr.CtxRateUnknown), "error != CtxRateUnknown")
+
+	_, err = operateMoney(data.PowToken, usd("12"), data.NewDecimalToken(2), opts)
+	assert.True(t, ierr.As(err, ierr.CtxValueOperation), "error != CtxValueOperation")
+
+	value, err = convertMoney(eur, "USD", opts)
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "11.00 USD", Format(value))
+}
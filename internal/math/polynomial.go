@@ -0,0 +1,182 @@
+package math
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// epsilon is the distance from 1 to the next float64
+const epsilon = 0x1p-52
+
+// maxRootIterations is the limit of iterations of the Durand-Kerner method
+const maxRootIterations = 1000
+
+// roots returns the roots of a polynomial whose coefficients go from the highest degree
+// to the constant term, where a real root is a Decimal and the rest are a Complex
+func roots(args []data.Token) (data.Token, error) {
+	coeffs, err := toFloats(args)
+	if err != nil {
+		return nil, err
+	}
+
+	zs, err := Roots(coeffs)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]data.Token, len(zs))
+	for i, z := range zs {
+		if imag(z) == 0 {
+			values[i] = data.NewDecimalToken(real(z))
+			continue
+		}
+		values[i] = data.NewComplexToken(z)
+	}
+
+	return data.NewArrayToken(values), nil
+}
+
+// Roots returns the real and complex roots of a polynomial, whose coefficients go from the highest degree
+// to the constant term, sorted by their real part and then by their imaginary one, and nil
+// using the Durand-Kerner method, where a root whose imaginary part is only a rounding error is real,
+// otherwise returns nil and an error if the polynomial is a constant, which has no roots, or it does not converge
+//
+//	1, -3, 2 => 1, 2
+//	1, 0, 1 => -i, i
+func Roots(coeffs []float64) ([]complex128, error) {
+	for len(coeffs) > 0 && coeffs[0] == 0 {
+		coeffs = coeffs[1:]
+	}
+
+	if len(coeffs) < 2 {
+		return nil, ierr.NoRoots("roots")
+	}
+
+	var zs []complex128
+	for coeffs[len(coeffs)-1] == 0 {
+		coeffs = coeffs[:len(coeffs)-1]
+		zs = append(zs, 0)
+	}
+
+	monic := make([]complex128, len(coeffs))
+	for i, c := range coeffs {
+		monic[i] = complex(c/coeffs[0], 0)
+	}
+
+	found, err := durandKerner(monic)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, z := range found {
+		z = polish(monic, z)
+		if isRealRoot(monic, z) {
+			z = complex(real(z), 0)
+		}
+		zs = append(zs, z)
+	}
+
+	sort.Slice(zs, func(i, j int) bool {
+		if real(zs[i]) != real(zs[j]) {
+			return real(zs[i]) < real(zs[j])
+		}
+		return imag(zs[i]) < imag(zs[j])
+	})
+	return zs, nil
+}
+
+// !Tool Functions
+
+// durandKerner returns the roots of a monic polynomial, which start on a circle
+// whose radius bounds all of them, otherwise returns an error if it does not converge
+func durandKerner(monic []complex128) ([]complex128, error) {
+	n := len(monic) - 1
+
+	radius := 0.0
+	for _, c := range monic[1:] {
+		radius = math.Max(radius, cmplx.Abs(c))
+	}
+
+	zs := make([]complex128, n)
+	for k := range zs {
+		zs[k] = cmplx.Rect(1+radius, 2*math.Pi*float64(k)/float64(n)+0.4)
+	}
+
+	for i := 0; i < maxRootIterations; i++ {
+		converged := true
+
+		for k, z := range zs {
+			den := complex(1, 0)
+			for j, w := range zs {
+				if j != k {
+					den *= z - w
+				}
+			}
+
+			y, bound := horner(monic, z)
+			step := y / den
+			if cmplx.IsNaN(step) || cmplx.IsInf(step) {
+				return nil, ierr.NoConvergence("roots")
+			}
+
+			zs[k] = z - step
+			if cmplx.Abs(step) > 1e-14*math.Max(1, cmplx.Abs(z)) && cmplx.Abs(y) > bound {
+				converged = false
+			}
+		}
+
+		if converged {
+			return zs, nil
+		}
+	}
+
+	return nil, ierr.NoConvergence("roots")
+}
+
+// polish returns the root improved by the Newton method while the polynomial gets closer to zero
+func polish(monic []complex128, z complex128) complex128 {
+	y, _ := horner(monic, z)
+
+	for i := 0; i < 3 && y != 0; i++ {
+		var p, dp complex128
+		for _, c := range monic {
+			dp = dp*z + p
+			p = p*z + c
+		}
+
+		next := z - p/dp
+		ny, _ := horner(monic, next)
+		if cmplx.IsNaN(next) || cmplx.Abs(ny) >= cmplx.Abs(y) {
+			break
+		}
+		z, y = next, ny
+	}
+	return z
+}
+
+// isRealRoot returns true if the imaginary part of the root is only a rounding error,
+// where the polynomial is zero at its real part, otherwise returns false
+func isRealRoot(monic []complex128, z complex128) bool {
+	if math.Abs(imag(z)) <= 1e-14*math.Max(1, cmplx.Abs(z)) {
+		return true
+	}
+
+	y, bound := horner(monic, complex(real(z), 0))
+	return cmplx.Abs(y) <= 4*bound
+}
+
+// horner returns the value of the polynomial at z and the bound of its rounding error
+func horner(coeffs []complex128, z complex128) (complex128, float64) {
+	var y complex128
+	var bound float64
+
+	for _, c := range coeffs {
+		y = y*z + c
+		bound = bound*cmplx.Abs(z) + cmplx.Abs(c)
+	}
+	return y, 8 * float64(len(coeffs)) * epsilon * bound
+}
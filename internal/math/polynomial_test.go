@@ -0,0 +1,46 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoots(t *testing.T) {
+	value, err := roots([]data.Token{data.NewDecimalToken(1), data.NewDecimalToken(0), data.NewDecimalToken(4)})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "[-2i, 2i]", Format(value))
+
+	value, err = roots([]data.Token{vector(1, -6, 11, -6)})
+	assert.Nil(t, err, "error != nil")
+	for i, e := range value.(data.Array).Value() {
+		assert.InDelta(t, float64(i+1), e.(data.Decimal).Value(), 1e-12)
+	}
+
+	zs, err := Roots([]float64{1, -2, 1})
+	assert.Nil(t, err, "error != nil")
+	assert.Len(t, zs, 2)
+	for _, z := range zs {
+		assert.Zero(t, imag(z))
+		assert.InDelta(t, 1, real(z), 1e-7)
+	}
+
+	zs, err = Roots([]float64{1, 0, 0, 0, 0, -1})
+	assert.Nil(t, err, "error != nil")
+	assert.Len(t, zs, 5)
+	for _, z := range zs {
+		y, _ := horner([]complex128{1, 0, 0, 0, 0, -1}, z)
+		assert.InDelta(t, 0, real(y), 1e-12)
+		assert.InDelta(t, 0, imag(y), 1e-12)
+	}
+
+	for _, coeffs := range [][]float64{{0, 0, 3}, {5}, {0}, {}} {
+		_, err = Roots(coeffs)
+		assert.True(t, ierr.As(err, ierr.CtxNoRoots), "error != CtxNoRoots")
+	}
+
+	_, err = roots([]data.Token{data.NewDecimalToken(0)})
+	assert.True(t, ierr.As(err, ierr.CtxNoRoots), "error != CtxNoRoots")
+}
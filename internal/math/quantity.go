@@ -0,0 +1,184 @@
+package math
+
+import (
+	"math"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/doubly"
+)
+
+// operateQuantity returns the value token of operating x and y, where at least one of them
+// is a Quantity, and nil, otherwise returns nil and an error if their dimensions do not allow it:
+//
+//	3 km + 200 m = 3.2 km
+//	3 km / 20 min = 2.5 m/s
+//	3 m + 2 s => error
+//	0 - 3 m = -3 m
+func operateQuantity(kind data.TokenKind, x, y data.Token) (data.Token, error) {
+	qx, okX := toQuantity(x)
+	qy, okY := toQuantity(y)
+
+	if !okX || !okY {
+		return nil, ierr.ValueOperation(data.RuneMap[kind])
+	}
+
+	ux, uy := qx.Unit(), qy.Unit()
+	_, isNumX := x.(data.Decimal)
+	_, isNumY := y.(data.Decimal)
+
+	switch kind {
+	case data.AddToken, data.SubToken, data.ModToken:
+		// a plain zero like the one of -3 m => 0-(3*m) has any dimension
+		if isNumX && qx.Value() == 0 {
+			ux = uy
+		}
+
+		if ux.Dim != uy.Dim {
+			return nil, ierr.DimensionMismatch(ux.Dim.String(), uy.Dim.String())
+		}
+
+		vx, vy := qx.Value(), toSI(qy)/ux.Scale
+
+		switch kind {
+		case data.AddToken:
+			return newQuantity(vx+vy, ux), nil
+		case data.SubToken:
+			return newQuantity(vx-vy, ux), nil
+		}
+		return newQuantity(math.Mod(vx, vy), ux), nil
+
+	case data.MulToken:
+		switch {
+		case isNumY:
+			return newQuantity(qx.Value()*qy.Value(), ux), nil
+		case isNumX:
+			return newQuantity(qx.Value()*qy.Value(), uy), nil
+		}
+		return newQuantity(toSI(qx)*toSI(qy), siUnit(ux.Dim.Add(uy.Dim))), nil
+
+	case data.DivToken:
+		if isNumY {
+			return newQuantity(qx.Value()/qy.Value(), ux), nil
+		}
+		return newQuantity(toSI(qx)/toSI(qy), siUnit(ux.Dim.Sub(uy.Dim))), nil
+	}
+
+	if !uy.Dim.IsZero() {
+		return nil, ierr.DimensionMismatch(uy.Dim.String(), data.Dimension{}.String())
+	}
+
+	dim, ok := powDimension(ux.Dim, qy.Value())
+	if !ok {
+		return nil, ierr.ValueOperation(data.Pow)
+	}
+
+	return newQuantity(math.Pow(toSI(qx), qy.Value()), siUnit(dim)), nil
+}
+
+// sqrtQuantity returns the value token of the square root of the quantity and nil,
+// otherwise returns nil and an error if its dimension has an odd power
+func sqrtQuantity(q data.Quantity) (data.Token, error) {
+	dim, ok := powDimension(q.Unit().Dim, 0.5)
+	if !ok {
+		return nil, ierr.ValueOperation(data.Root)
+	}
+
+	return newQuantity(math.Sqrt(toSI(q)), siUnit(dim)), nil
+}
+
+// doConvert converts the value before the ConvertToken node to the unit or the currency after it
+//
+//	5 ft → m = 1.524 m
+//	12 EUR → USD = 13.00 USD
+func doConvert(list *doubly.Doubly, temp *doubly.Node, opts Options) error {
+	x, target := temp.Prev().Token(), temp.Next().Token()
+
+	err := guard(opts)
+	if err != nil {
+		return err
+	}
+
+	if isKind(temp.Next(), data.CurrencyToken) {
+		value, err := convertMoney(x, target.(data.Word).Value(), opts)
+		if err != nil {
+			return err
+		}
+
+		temp.Update(value)
+	} else {
+		q, ok := toQuantity(x)
+		if !ok {
+			return ierr.ValueOperation(data.Convert)
+		}
+
+		unit, _ := data.ParseUnit(target.(data.Word).Value())
+		if q.Unit().Dim != unit.Dim {
+			return ierr.DimensionMismatch(q.Unit().Dim.String(), unit.Dim.String())
+		}
+
+		temp.Update(data.NewQuantityToken(toSI(q)/unit.Scale, unit))
+	}
+
+	removeNodeEnds(list, temp)
+	if opts.Trace != nil {
+		opts.Trace(Step{Op: string(data.Convert), Operands: []data.Token{x, target}, Result: temp.Token(), List: list})
+	}
+	return nil
+}
+
+// !Tool Functions
+
+// newQuantity returns a token Quantity, or a token Decimal if the unit is dimensionless
+func newQuantity(value float64, unit data.Unit) data.Token {
+	if unit.Dim.IsZero() {
+		return data.NewDecimalToken(value * unit.Scale)
+	}
+	return data.NewQuantityToken(value, unit)
+}
+
+// toQuantity returns the value token as a Quantity, where a Decimal is dimensionless, and true,
+// otherwise returns false
+func toQuantity(value data.Token) (data.Quantity, bool) {
+	switch value := value.(type) {
+	case data.Decimal:
+		return data.NewQuantityToken(value.Value(), siUnit(data.Dimension{})).(data.Quantity), true
+	case data.Quantity:
+		return value, true
+	}
+	return data.Quantity{}, false
+}
+
+// toSI returns the value of the quantity in SI base units
+func toSI(q data.Quantity) float64 {
+	return q.Value() * q.Unit().Scale
+}
+
+// siUnit returns the unit of the dimension in SI base units, which has no name
+func siUnit(dim data.Dimension) data.Unit {
+	return data.Unit{Scale: 1, Dim: dim}
+}
+
+// powDimension returns the dimension raised to the n power and true,
+// otherwise returns false if any of its powers is not an integer
+func powDimension(dim data.Dimension, n float64) (data.Dimension, bool) {
+	for i, exp := range dim {
+		power := float64(exp) * n
+		if power != math.Trunc(power) || math.Abs(power) > math.MaxInt8 {
+			return data.Dimension{}, false
+		}
+		dim[i] = int8(power)
+	}
+	return dim, true
+}
+
+// formatQuantity returns the quantity with its unit, or with its SI base units if the unit has no name
+//
+//	1.524 m, 2.5 m/s
+func formatQuantity(q data.Quantity) string {
+	name := q.Unit().Name
+	if name == "" {
+		name = q.Unit().Dim.String()
+	}
+	return formatFloat(q.Value()) + string(data.Gap) + name
+}
@@ -0,0 +1,66 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperateQuantity(t *testing.T) {
+	km, _ := data.LookupUnit("km")
+	m, _ := data.LookupUnit("m")
+	s, _ := data.LookupUnit("s")
+
+	value, err := operateQuantity(data.AddToken, data.NewQuantityToken(3, km), data.NewQuantityToken(200, m))
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "3.2 km", Format(value))
+
+	value, err = operateQuantity(data.DivToken, data.NewQuantityToken(3, km), data.NewQuantityToken(20, s))
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "150 m/s", Format(value))
+
+	value, err = operateQuantity(data.DivToken, data.NewQuantityToken(3, km), data.NewQuantityToken(1500, m))
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, data.NewDecimalToken(2), value)
+
+	value, err = operateQuantity(data.PowToken, data.NewQuantityToken(3, m), data.NewDecimalToken(2))
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "9 m^2", Format(value))
+
+	_, err = operateQuantity(data.AddToken, data.NewQuantityToken(3, m), data.NewQuantityToken(2, s))
+	assert.True(t, ierr.As(err, ierr.CtxDimensionMismatch), "error != CtxDimensionMismatch")
+
+	_, err = operateQuantity(data.PowToken, data.NewDecimalToken(2), data.NewQuantityToken(2, s))
+	assert.True(t, ierr.As(err, ierr.CtxDimensionMismatch), "error != CtxDimensionMismatch")
+
+	_, err = operateQuantity(data.MulToken, data.NewComplexToken(1i), data.NewQuantityToken(2, s))
+	assert.True(t, ierr.As(err, ierr.CtxValueOperation), "error != CtxValueOperation")
+}
+
+func TestParseUnit(t *testing.T) {
+	tests := []struct {
+		expr  string
+		scale float64
+		dim   string
+	}{
+		{expr: "km/h", scale: 1000.0 / 3600, dim: "m/s"},
+		{expr: "kg*m^2/s^2", scale: 1, dim: "kg*m^2/s^2"},
+		{expr: "1/s", scale: 1, dim: "1/s"},
+		{expr: "mL", scale: 1e-6, dim: "m^3"},
+		{expr: "N * m", scale: 1, dim: "kg*m^2/s^2"},
+	}
+
+	for _, tt := range tests {
+		unit, ok := data.ParseUnit(tt.expr)
+		assert.Truef(t, ok, "%s: unknown unit", tt.expr)
+		assert.InDeltaf(t, tt.scale, unit.Scale, 1e-15, "%s", tt.expr)
+		assert.Equalf(t, tt.dim, unit.Dim.String(), "%s", tt.expr)
+	}
+
+	for _, expr := range []string{"", "xyz", "m^x", "m//s", "2/s"} {
+		_, ok := data.ParseUnit(expr)
+		assert.Falsef(t, ok, "%s: known unit", expr)
+	}
+}
@@ -0,0 +1,245 @@
+package math
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/doubly"
+)
+
+// maxSeriesTerms is the limit of terms of a sum or a product of a series
+const maxSeriesTerms = 1_000_000
+
+// bound resolves the variable bound by a sum, a product or an integral,
+// and the rest of the names by its parent env, which can be nil
+type bound struct {
+	env   Env
+	name  string
+	value data.Token
+}
+
+// Var returns the value of the bound variable or the one of the parent env and true,
+// otherwise returns false
+func (b bound) Var(name string) (data.Token, bool) {
+	if name == b.name {
+		return b.value, true
+	}
+
+	if b.env == nil {
+		return nil, false
+	}
+	return b.env.Var(name)
+}
+
+// Func returns the function of the parent env and true, otherwise returns false
+func (b bound) Func(name string) (Func, bool) {
+	if b.env == nil {
+		return nil, false
+	}
+	return b.env.Func(name)
+}
+
+// !Tool Functions
+
+// doBindings replaces each call that binds a variable, from left to right, with its value,
+// since its first argument can not be calculated before the variable has a value:
+//
+//	sum(expr, k, from, to), prod(expr, k, from, to), integrate(expr, x, a, b)
+func doBindings(list *doubly.Doubly, env Env, opts Options) error {
+	for temp := list.Head(); temp != nil; temp = temp.Next() {
+		if !isFunction(temp) {
+			continue
+		}
+
+		word := temp.Token().(data.Word).Value()
+		name, isSymbol := data.SeriesName(word)
+		if !isSymbol {
+			name = word
+		}
+
+		if name != "sum" && name != "prod" && name != "integrate" {
+			continue
+		}
+
+		args, right := splitArguments(temp.Next())
+		if name == "sum" && !isSymbol && !isSeries(args) {
+			continue
+		}
+
+		if len(args) != 4 || !isBoundVariable(args[1]) {
+			return ierr.NameMisspelled(word)
+		}
+
+		// the steps inside the call are not the ones of the list
+		inner := opts
+		inner.Trace = nil
+
+		value, err := bind(name, args, env, inner)
+		if err != nil {
+			return err
+		}
+
+		for temp.Next() != right {
+			list.RemoveNode(temp.Next())
+		}
+		list.RemoveNode(right)
+		temp.Update(value)
+
+		if opts.Trace != nil {
+			opts.Trace(Step{Op: name, Labels: labelsOf(args), Result: value, List: list})
+		}
+	}
+	return nil
+}
+
+// splitArguments returns the tokens of each argument of the call opened by 'left'
+// and the node of its RightToken
+func splitArguments(left *doubly.Node) ([][]data.Token, *doubly.Node) {
+	var args [][]data.Token
+	var arg []data.Token
+	depth := 0
+
+	for temp := left; temp != nil; temp = temp.Next() {
+		switch {
+		case isKind(temp, data.LeftToken):
+			depth++
+			if depth == 1 {
+				continue
+			}
+
+		case isKind(temp, data.RightToken):
+			depth--
+			if depth == 0 {
+				return append(args, arg), temp
+			}
+
+		case isKind(temp, data.CommaToken) && depth == 1:
+			args = append(args, arg)
+			arg = nil
+			continue
+		}
+
+		arg = append(arg, temp.Token())
+	}
+	return append(args, arg), nil
+}
+
+// labelsOf returns each argument written as an expression,
+// since the first one has not a value without the bound variable
+func labelsOf(args [][]data.Token) []string {
+	labels := make([]string, len(args))
+	for i, arg := range args {
+		list := doubly.New()
+		for _, token := range arg {
+			list.PushBack(token)
+		}
+		labels[i] = Expression(list)
+	}
+	return labels
+}
+
+// isSeries returns true if the arguments of a sum are the ones of a series,
+// which are four whose second one is only a variable, otherwise returns false
+//
+//	sum(k^2, k, 1, 10) => true
+//	sum(2, k, 1, 10) => true
+//	sum(1, 2, 3, 4) => false
+func isSeries(args [][]data.Token) bool {
+	return len(args) == 4 && isBoundVariable(args[1])
+}
+
+// isBoundVariable returns true if the argument is only a variable, otherwise returns false
+func isBoundVariable(arg []data.Token) bool {
+	return len(arg) == 1 && arg[0].Kind() == data.VarToken
+}
+
+// bind returns the value of the call that binds the variable of its second argument
+func bind(name string, args [][]data.Token, env Env, opts Options) (data.Token, error) {
+	from, err := evaluateFloat(args[2], env, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := evaluateFloat(args[3], env, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	variable := args[1][0].(data.Word).Value()
+
+	if name == "integrate" {
+		f := func(x float64) (float64, error) {
+			err := guard(opts)
+			if err != nil {
+				return 0, err
+			}
+			return evaluateFloat(args[0], bound{env: env, name: variable, value: data.NewDecimalToken(x)}, opts)
+		}
+
+		value, _, err := Integrate(f, from, to)
+		if err != nil {
+			return nil, err
+		}
+		return data.NewDecimalToken(value), nil
+	}
+
+	return series(name, args[0], variable, from, to, env, opts)
+}
+
+// series returns the sum or the product of the body for each integer value of the variable
+// from 'from' to 'to', which is 0 or 1 if the range is empty, otherwise returns an error
+// if a bound is not an integer or there are too many terms
+func series(name string, body []data.Token, variable string, from, to float64, env Env, opts Options) (data.Token, error) {
+	for _, limit := range [2]float64{from, to} {
+		if limit != math.Trunc(limit) {
+			return nil, ierr.BoundNotInteger(strconv.FormatFloat(limit, 'g', -1, 64))
+		}
+	}
+
+	if to-from+1 > maxSeriesTerms {
+		return nil, ierr.IterationLimit(name)
+	}
+
+	kind, value := data.AddToken, data.Token(data.NewDecimalToken(0))
+	if name == "prod" {
+		kind, value = data.MulToken, data.NewDecimalToken(1)
+	}
+
+	for k := from; k <= to; k++ {
+		err := guard(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		term, err := evaluateTokens(body, bound{env: env, name: variable, value: data.NewDecimalToken(k)}, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err = operate(kind, value, term, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+// evaluateTokens returns the value token of calculating the expression of the tokens
+func evaluateTokens(tokens []data.Token, env Env, opts Options) (data.Token, error) {
+	list := doubly.New()
+	for _, token := range tokens {
+		list.PushBack(token)
+	}
+	return Evaluate(list, env, opts)
+}
+
+// evaluateFloat returns the real number of calculating the expression of the tokens
+func evaluateFloat(tokens []data.Token, env Env, opts Options) (float64, error) {
+	value, err := evaluateTokens(tokens, env, opts)
+	if err != nil {
+		return 0, err
+	}
+	return Float(value)
+}
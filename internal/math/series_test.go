@@ -0,0 +1,61 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeries(t *testing.T) {
+	tests := []struct {
+		expr string
+		want float64
+		as   ierr.KindOf
+	}{
+		{expr: "sum(k^2, k, 1, 10)", want: 385},
+		{expr: "Σ(k, k, 1, 100) / 2", want: 2525},
+		{expr: "∏(k, k, 1, 5)", want: 120},
+		{expr: "sum(k, k, 1, 0) + prod(k, k, 1, 0)", want: 1},
+		{expr: "sum(1, 2, 3, 4)", want: 10},
+		{expr: "Σ(2, k, 1, 3) + sum(2, k, 1, 3) + ∏(2, k, 1, 3)", want: 20},
+		{expr: "Σ(1, 2, 3, 4)", as: ierr.CtxNameMisspelled},
+		{expr: "sum(sum(j*k, j, 1, k), k, 1, 3)", want: 25},
+		{expr: "sum(prod(2, j, 1, k), k, 0, 3)", want: 15},
+		{expr: "integrate(x^2, x, 0, 3)", want: 9},
+		{expr: "integrate(sum(x^k, k, 0, 2), x, 0, 1)", want: 11.0 / 6},
+		{expr: "sum(k, k, 1, 10000000)", as: ierr.CtxIterationLimit},
+		{expr: "sum(k, k, 3, 1) + prod(k, k, 3, 1)", want: 1},
+		{expr: "sum(k, k, 0.5, 2)", as: ierr.CtxBoundNotInteger},
+		{expr: "prod(k, k, 1, 2.5)", as: ierr.CtxBoundNotInteger},
+		{expr: "prod(k, 2, 1, 3)", as: ierr.CtxNameMisspelled},
+		{expr: "integrate(y, x, 0, 1)", as: ierr.CtxNameUnknown},
+	}
+
+	for _, tt := range tests {
+		got, err := Math(toList(tt.expr), nil, Options{})
+		if tt.as != "" {
+			assert.Truef(t, ierr.As(err, tt.as), "%s: error != %v", tt.expr, tt.as)
+			continue
+		}
+
+		assert.Nilf(t, err, "%s: error != nil", tt.expr)
+		assert.InDeltaf(t, tt.want, got, 1e-9, "%s", tt.expr)
+	}
+}
+
+func TestSeriesBound(t *testing.T) {
+	_, err := Math(toList("sum(k, k, 1, 2.5)"), nil, Options{})
+	assert.EqualError(t, err, "math error: this bound of a series is not an integer: 2.5")
+}
+
+func TestSplitArguments(t *testing.T) {
+	list := toList("sum((k+1)^2, k, f(1, 2), 3) + 1")
+
+	args, right := splitArguments(list.Head().Next())
+	assert.Len(t, args, 4)
+	assert.Equal(t, 7, len(args[0]))
+	assert.Equal(t, 6, len(args[2]))
+	assert.Equal(t, data.AddToken, right.Next().Token().Kind())
+}
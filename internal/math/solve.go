@@ -0,0 +1,200 @@
+package math
+
+import (
+	"math"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+)
+
+// maxIterations is the limit of iterations of the solvers of the financial functions
+const maxIterations = 100
+
+// tolerance is the relative size of the last step for the solvers of the financial functions to converge
+const tolerance = 1e-12
+
+// Solve returns a root of f near the guess and nil using the Newton-Raphson method, which falls back
+// to the Brent method inside a bracket around the guess where f changes its sign, where tol is
+// the relative size of the last step to converge and maxIter is the limit of iterations of each method,
+// otherwise returns NaN and an error if f fails or none of them converges
+func Solve(f func(x float64) (float64, error), guess, tol float64, maxIter int) (float64, error) {
+	x, ok, err := newtonRaphson(f, guess, tol, maxIter)
+	if ok || err != nil {
+		return x, err
+	}
+
+	a, b, ok, err := bracket(f, guess, maxIter)
+	if err != nil {
+		return math.NaN(), err
+	}
+
+	if ok {
+		x, ok, err = brent(f, a, b, tol, maxIter)
+		if ok || err != nil {
+			return x, err
+		}
+	}
+
+	return math.NaN(), ierr.NoConvergence("solve")
+}
+
+// !Tool Functions
+
+// newtonRaphson returns the root of f near the guess and true using the Newton-Raphson method
+// with a central difference as derivative, which is the solver of Solve and of the financial functions,
+// otherwise returns false if it does not converge or an error if f fails
+func newtonRaphson(f func(x float64) (float64, error), guess, tol float64, maxIter int) (float64, bool, error) {
+	x := guess
+
+	for i := 0; i < maxIter; i++ {
+		y, err := f(x)
+		if err != nil || y == 0 {
+			return x, err == nil, err
+		}
+
+		h := 1e-6 * math.Max(1, math.Abs(x))
+
+		right, err := f(x + h)
+		if err != nil {
+			return x, false, err
+		}
+
+		left, err := f(x - h)
+		if err != nil {
+			return x, false, err
+		}
+
+		step := y / ((right - left) / (2 * h))
+		if math.IsNaN(step) || math.IsInf(step, 0) {
+			return x, false, nil
+		}
+
+		x -= step
+		if math.Abs(step) <= tol*math.Max(1, math.Abs(x)) {
+			return x, true, nil
+		}
+	}
+
+	return x, false, nil
+}
+
+// bracket returns an interval around the guess where f changes its sign and true,
+// which is found by doubling its width, otherwise returns false or an error if f fails
+func bracket(f func(x float64) (float64, error), guess float64, maxIter int) (a, b float64, ok bool, err error) {
+	y, err := f(guess)
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	width := 0.1 * math.Max(1, math.Abs(guess))
+
+	for i := 0; i < maxIter; i++ {
+		for _, x := range [2]float64{guess - width, guess + width} {
+			yx, err := f(x)
+			if err != nil {
+				return 0, 0, false, err
+			}
+
+			if math.Signbit(yx) != math.Signbit(y) && !math.IsNaN(yx) && !math.IsNaN(y) {
+				return math.Min(guess, x), math.Max(guess, x), true, nil
+			}
+		}
+		width *= 2
+	}
+
+	return 0, 0, false, nil
+}
+
+// brent returns the root of f between a and b, where f changes its sign, and true
+// using the Brent method, otherwise returns false if it does not converge or an error if f fails,
+// which is also the case of a pole where f changes its sign, like 1/x at 0
+func brent(f func(x float64) (float64, error), a, b, tol float64, maxIter int) (float64, bool, error) {
+	fa, err := f(a)
+	if err != nil {
+		return 0, false, err
+	}
+
+	fb, err := f(b)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// the value of f at a root is not greater than the ones at the ends of the bracket
+	residual := math.Max(math.Abs(fa), math.Abs(fb))
+
+	c, fc := b, fb
+	var d, e float64
+
+	for i := 0; i < maxIter; i++ {
+		// c is the previous point where f has the opposite sign of f(b)
+		if math.Signbit(fb) == math.Signbit(fc) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+
+		// b is the best estimate so far
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		delta := 2*math.SmallestNonzeroFloat64 + 0.5*tol*math.Max(1, math.Abs(b))
+		m := 0.5 * (c - b)
+
+		if math.Abs(m) <= delta || fb == 0 {
+			return b, isResidual(fb, residual) && isResidual(fc, math.Inf(1)), nil
+		}
+
+		if math.Abs(e) < delta || math.Abs(fa) <= math.Abs(fb) {
+			d, e = m, m
+		} else {
+			d, e = interpolate(a, b, c, fa, fb, fc, m, d, e, delta)
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > delta {
+			b += d
+		} else {
+			b += math.Copysign(delta, m)
+		}
+
+		fb, err = f(b)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	return b, false, nil
+}
+
+// isResidual returns true if y is a finite value that is not greater than the residual, otherwise returns false
+func isResidual(y, residual float64) bool {
+	return !math.IsNaN(y) && !math.IsInf(y, 0) && math.Abs(y) <= residual
+}
+
+// interpolate returns the next step of the Brent method by the secant method or
+// the inverse quadratic interpolation, which is the bisection if it is not acceptable
+func interpolate(a, b, c, fa, fb, fc, m, d, e, delta float64) (float64, float64) {
+	var p, q float64
+
+	s := fb / fa
+	if a == c {
+		p = 2 * m * s
+		q = 1 - s
+	} else {
+		q, r := fa/fc, fb/fc
+		p = s * (2*m*q*(q-r) - (b-a)*(r-1))
+		q = (q - 1) * (r - 1) * (s - 1)
+	}
+
+	if p > 0 {
+		q = -q
+	} else {
+		p = -p
+	}
+
+	if 2*p < math.Min(3*m*q-math.Abs(delta*q), math.Abs(e*q)) {
+		return p / q, d
+	}
+	return m, m
+}
@@ -0,0 +1,54 @@
+package math
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		f     func(x float64) (float64, error)
+		guess float64
+		want  float64
+	}{
+		{f: func(x float64) (float64, error) { return x*x - 2, nil }, guess: 1, want: math.Sqrt2},
+		{f: func(x float64) (float64, error) { return math.Cos(x) - x, nil }, guess: 1, want: 0.7390851332151607},
+		{f: func(x float64) (float64, error) { return math.Cbrt(x - 3), nil }, guess: 1, want: 3},
+		{f: func(x float64) (float64, error) { return math.Atan(x - 50), nil }, guess: 0, want: 50},
+	}
+
+	for _, tt := range tests {
+		got, err := Solve(tt.f, tt.guess, 1e-12, 100)
+		assert.Nil(t, err, "error != nil")
+		assert.InDelta(t, tt.want, got, 1e-9)
+	}
+
+	_, err := Solve(func(x float64) (float64, error) { return x*x + 1, nil }, 1, 1e-12, 100)
+	assert.True(t, ierr.As(err, ierr.CtxNoConvergence), "error != CtxNoConvergence")
+
+	_, err = Solve(func(x float64) (float64, error) { return 1 / x, nil }, 1, 1e-12, 100)
+	assert.True(t, ierr.As(err, ierr.CtxNoConvergence), "error != CtxNoConvergence")
+
+	bug := errors.New("bug")
+	_, err = Solve(func(x float64) (float64, error) { return 0, bug }, 1, 1e-12, 100)
+	assert.ErrorIs(t, err, bug)
+}
+
+func TestBrent(t *testing.T) {
+	f := func(x float64) (float64, error) { return x*x*x - x - 2, nil }
+
+	got, ok, err := brent(f, 1, 2, 1e-12, 100)
+	assert.Nil(t, err, "error != nil")
+	assert.True(t, ok, "it does not converge")
+	assert.InDelta(t, 1.5213797068045676, got, 1e-12)
+
+	_, ok, _ = brent(f, 1, 2, 1e-12, 2)
+	assert.False(t, ok, "it converges")
+
+	_, ok, _ = brent(func(x float64) (float64, error) { return 1 / x, nil }, -1, 2, 1e-12, 100)
+	assert.False(t, ok, "it converges to a pole")
+}
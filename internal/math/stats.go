@@ -0,0 +1,139 @@
+package math
+
+import (
+	"math"
+	"sort"
+)
+
+// compensated represents a running sum and the compensation of its lost low-order bits
+type compensated struct {
+	sum, c float64
+}
+
+// add adds x with the Kahan-Babuška-Neumaier compensated summation
+func (k *compensated) add(x float64) {
+	t := k.sum + x
+
+	if math.Abs(k.sum) >= math.Abs(x) {
+		k.c += (k.sum - t) + x
+	} else {
+		k.c += (x - t) + k.sum
+	}
+
+	k.sum = t
+}
+
+// result returns the compensated sum
+func (k compensated) result() float64 {
+	return k.sum + k.c
+}
+
+// Sum returns the sum of xs using the Kahan-Babuška-Neumaier compensated summation
+func Sum(xs []float64) float64 {
+	var sum compensated
+	for _, x := range xs {
+		sum.add(x)
+	}
+	return sum.result()
+}
+
+// Avg returns the arithmetic mean of xs
+func Avg(xs []float64) float64 {
+	return Sum(xs) / float64(len(xs))
+}
+
+// Median returns the value in the middle of xs once sorted,
+// or the mean of both values in the middle if len(xs) is even
+func Median(xs []float64) float64 {
+	return Percentile(50, xs)
+}
+
+// Mode returns the most frequent value of xs,
+// or the smallest of them if there are several
+func Mode(xs []float64) float64 {
+	sorted := sortedCopy(xs)
+	mode, best := sorted[0], 0
+
+	for i := 0; i < len(sorted); {
+		j := i
+		for j < len(sorted) && sorted[j] == sorted[i] {
+			j++
+		}
+
+		if j-i > best {
+			mode, best = sorted[i], j-i
+		}
+
+		if j == i {
+			j++ // NaN is never equal to itself
+		}
+		i = j
+	}
+
+	return mode
+}
+
+// Var returns the sample variance of xs using the Welford's online algorithm
+func Var(xs []float64) float64 {
+	var mean, m2 float64
+
+	for i, x := range xs {
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+
+	return m2 / float64(len(xs)-1)
+}
+
+// StdDev returns the sample standard deviation of xs
+func StdDev(xs []float64) float64 {
+	return math.Sqrt(Var(xs))
+}
+
+// Min returns the smallest value of xs
+func Min(xs []float64) float64 {
+	min := xs[0]
+	for _, x := range xs[1:] {
+		min = math.Min(min, x)
+	}
+	return min
+}
+
+// Max returns the largest value of xs
+func Max(xs []float64) float64 {
+	max := xs[0]
+	for _, x := range xs[1:] {
+		max = math.Max(max, x)
+	}
+	return max
+}
+
+// Percentile returns the p-th percentile of xs, where p is between 0 and 100,
+// interpolating linearly between the closest ranks; otherwise returns NaN
+func Percentile(p float64, xs []float64) float64 {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return math.NaN()
+	}
+
+	sorted := sortedCopy(xs)
+	rank := p / 100 * float64(len(sorted)-1)
+
+	lo := int(math.Floor(rank))
+	if lo == len(sorted)-1 {
+		return sorted[lo]
+	}
+
+	frac := rank - float64(lo)
+	return sorted[lo] + frac*(sorted[lo+1]-sorted[lo])
+}
+
+// !Tool Functions
+
+// sortedCopy returns a sorted copy of xs
+func sortedCopy(xs []float64) []float64 {
+	sorted := make([]float64, len(xs))
+	copy(sorted, xs)
+	sort.Float64s(sorted)
+	return sorted
+}
@@ -0,0 +1,52 @@
+package math
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregates(t *testing.T) {
+	xs := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	assert.Equal(t, 40.0, Sum(xs))
+	assert.Equal(t, 5.0, Avg(xs))
+	assert.Equal(t, 4.5, Median(xs))
+	assert.Equal(t, 4.0, Mode(xs))
+	assert.InDelta(t, 32.0/7, Var(xs), 1e-15)
+	assert.InDelta(t, math.Sqrt(32.0/7), StdDev(xs), 1e-15)
+	assert.Equal(t, 2.0, Min(xs))
+	assert.Equal(t, 9.0, Max(xs))
+
+	assert.Equal(t, 5.0, Median([]float64{9, 5, 1}))
+	assert.Equal(t, 1.0, Mode([]float64{3, 1, 3, 1}))
+	assert.Equal(t, 2.0, Percentile(0, xs))
+	assert.Equal(t, 9.0, Percentile(100, xs))
+	assert.Equal(t, 4.0, Percentile(25, xs))
+	assert.True(t, math.IsNaN(Percentile(101, xs)))
+}
+
+func TestAggregatesStability(t *testing.T) {
+	t.Run("Compensated sum", func(t *testing.T) {
+		xs := []float64{1e100, 1, -1e100}
+		assert.Equal(t, 1.0, Sum(xs))
+
+		tenths := make([]float64, 1000)
+		for i := range tenths {
+			tenths[i] = 0.1
+		}
+		assert.Equal(t, 100.0, Sum(tenths))
+	})
+
+	t.Run("Variance with a large offset", func(t *testing.T) {
+		xs := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+		assert.Equal(t, 30.0, Var(xs))
+	})
+}
+
+func TestMathBuiltins(t *testing.T) {
+	result, err := Math(toList("sum(1, 2, 3) * avg(2, 4) - max(min(5, -1), 0) + percentile(50, 1, 3)"), nil, Options{})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, 20.0, result)
+}
@@ -0,0 +1,181 @@
+package math
+
+import (
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/doubly"
+)
+
+// Step represents an operation done while calculating the list of tokens,
+// where List is the list of tokens rewritten with its result, and Labels are the operands
+// written as expressions, which a sum, a product or an integral over a variable has
+// instead of Operands, since its arguments have no value without the variable
+type Step struct {
+	Op       string
+	Operands []data.Token
+	Labels   []string
+	Result   data.Token
+	List     *doubly.Doubly
+}
+
+// Expression returns the list of tokens as an expression that can be calculated,
+// without the parentheses that wrap all of it
+//
+//	((1+2)*3) => (1 + 2) * 3
+func Expression(list *doubly.Doubly) string {
+	var b strings.Builder
+	var arrays []bool
+
+	first, last := list.Head(), list.Tail()
+	if isWrapped(first, last) {
+		first, last = first.Next(), last.Prev()
+	}
+
+	for temp := first; temp != nil && temp != last.Next(); temp = temp.Next() {
+		switch temp.Token().Kind() {
+		case data.ArrayToken:
+			b.WriteRune(data.LeftBracket)
+			arrays = append(arrays, true)
+			temp = temp.Next()
+
+		case data.LeftToken:
+			if isValueGroup(temp) {
+				b.WriteString(formatValueAt(temp.Next().Token(), temp.Prev(), temp.Next().Next().Next()))
+				temp = temp.Next().Next()
+				continue
+			}
+
+			b.WriteRune(data.Left)
+			arrays = append(arrays, false)
+
+		case data.RightToken:
+			if len(arrays) > 0 && arrays[len(arrays)-1] {
+				b.WriteRune(data.RightBracket)
+			} else {
+				b.WriteRune(data.Right)
+			}
+
+			if len(arrays) > 0 {
+				arrays = arrays[:len(arrays)-1]
+			}
+
+		default:
+			b.WriteString(formatToken(temp))
+		}
+	}
+	return b.String()
+}
+
+// FormatToken returns a token, like the operand of a step, as a string,
+// where a word is its name, a symbol is its rune and a value is its formatted value
+func FormatToken(token data.Token) string {
+	if word, ok := token.(data.Word); ok {
+		return word.Value()
+	}
+
+	if number, ok := token.(data.Number); ok {
+		return number.Value()
+	}
+	return formatValue(token)
+}
+
+// !Tool Functions
+
+// isWrapped returns true if the 'first' node is a LeftToken that is closed by the 'last' node,
+// otherwise returns false
+func isWrapped(first, last *doubly.Node) bool {
+	if first == last || !isKind(first, data.LeftToken) {
+		return false
+	}
+
+	depth := 0
+	for temp := first; temp != nil; temp = temp.Next() {
+		switch temp.Token().Kind() {
+		case data.LeftToken:
+			depth++
+		case data.RightToken:
+			depth--
+		}
+
+		if depth == 0 {
+			return temp == last
+		}
+	}
+	return false
+}
+
+// isValueGroup returns true if the LeftToken node only groups one value that is not an argument,
+// whose parentheses are written if it needs them, otherwise returns false
+func isValueGroup(left *doubly.Node) bool {
+	if isFunction(left.Prev()) || left.Next() == nil || left.Next().Next() == nil {
+		return false
+	}
+
+	_, isSymbol := left.Next().Token().(data.Symbol)
+	return !isSymbol && isKind(left.Next().Next(), data.RightToken)
+}
+
+// formatToken returns the token of the node as it is written inside an expression
+func formatToken(node *doubly.Node) string {
+	token := node.Token()
+
+	switch token.Kind() {
+	case data.AddToken, data.SubToken, data.MulToken, data.DivToken, data.ProductToken, data.PlusMinusToken, data.ConvertToken:
+		if _, ok := token.(data.Symbol); ok {
+			return " " + string(data.RuneMap[token.Kind()]) + " "
+		}
+
+	case data.ModToken:
+		return " mod "
+
+	case data.CommaToken:
+		return ", "
+	}
+
+	switch token := token.(type) {
+	case data.Symbol:
+		return string(data.RuneMap[token.Kind()])
+	case data.Number:
+		return token.Value()
+	case data.Word:
+		return token.Value()
+	}
+
+	return formatValueAt(token, node.Prev(), node.Next())
+}
+
+// formatValueAt returns the value token as it is written between the 'prev' and 'next' nodes,
+// where a negative value is wrapped in parentheses unless it starts a group,
+// and a compound one unless it is alone in a group
+func formatValueAt(token data.Token, prev, next *doubly.Node) string {
+	s := formatValue(token)
+	if strings.HasPrefix(s, string(data.LeftBracket)) || len(s) < 2 {
+		return s
+	}
+
+	isFirst := prev == nil || isKind(prev, data.LeftToken) || isKind(prev, data.CommaToken)
+	isLast := next == nil || isKind(next, data.RightToken) || isKind(next, data.CommaToken)
+
+	if (strings.ContainsAny(s[1:], " +-") && !(isFirst && isLast)) || (s[0] == byte(data.Sub) && !isFirst) {
+		return string(data.Left) + s + string(data.Right)
+	}
+	return s
+}
+
+// formatValue returns the value token as a string, where a percentage is written with '%'
+func formatValue(token data.Token) string {
+	if token.Kind() != data.PercentToken {
+		return Format(token)
+	}
+
+	switch value := token.(type) {
+	case data.Interval:
+		return formatInterval(data.NewIntervalToken(value.Lo()*100, value.Hi()*100).(data.Interval)) + string(data.Mod)
+	case data.Uncertain:
+		return formatUncertain(data.NewUncertainToken(scaleUncertain(value, 0.01)).(data.Uncertain)) + string(data.Mod)
+	case data.Decimal:
+		return formatFloat(value.Value()*100) + string(data.Mod)
+	}
+	return Format(token)
+}
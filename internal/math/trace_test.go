@@ -0,0 +1,63 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpression(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "(1+2)*3", want: "(1 + 2) * 3"},
+		{expr: "((1+2)*3)", want: "(1 + 2) * 3"},
+		{expr: "(1+2)*(3+4)", want: "(1 + 2) * (3 + 4)"},
+		{expr: "f(x, -2)^2", want: "f(x, 0 - 2)^2"},
+		{expr: "[1, [2, 3]] · π", want: "[1, [2, 3]] · π"},
+		{expr: "7 mod √4", want: "7 mod √4"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Expression(toList(tt.expr)), tt.expr)
+	}
+
+	list := toList("1 * 2 - 3")
+	list.Head().Update(data.NewDecimalToken(-1))
+	list.Tail().Update(data.NewComplexToken(1 - 2i))
+	assert.Equal(t, "-1 * 2 - (1-2i)", Expression(list))
+}
+
+func TestTrace(t *testing.T) {
+	var steps []Step
+	trace := func(step Step) {
+		steps = append(steps, step)
+	}
+
+	value, err := Evaluate(toList("(1 + 2) * sqrt(4) + 3 * 2^2"), nil, Options{Trace: trace})
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, data.NewDecimalToken(18), value)
+
+	ops := make([]string, len(steps))
+	for i, step := range steps {
+		ops[i] = step.Op
+	}
+	assert.Equal(t, []string{"+", "sqrt", "^", "*", "*", "+"}, ops)
+	assert.Equal(t, []data.Token{data.NewDecimalToken(3), data.NewDecimalToken(2)}, steps[3].Operands)
+
+	steps = nil
+	_, err = Evaluate(toList("0.1 + 0.2 - 0.3"), nil, Options{Compensated: true, Trace: trace})
+	assert.Nil(t, err, "error != nil")
+	assert.Len(t, steps, 1)
+	assert.Equal(t, data.NewDecimalToken(-0.3), steps[0].Operands[2])
+
+	steps = nil
+	_, err = Evaluate(toList("sum(k^2, k, 1, 2 + 1)"), nil, Options{Trace: trace})
+	assert.Nil(t, err, "error != nil")
+	last := steps[len(steps)-1]
+	assert.Equal(t, "sum", last.Op)
+	assert.Nil(t, last.Operands)
+	assert.Equal(t, []string{"k^2", "k", "1", "2 + 1"}, last.Labels)
+}
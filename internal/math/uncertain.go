@@ -0,0 +1,157 @@
+package math
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// plusMinus returns the value x with the standard uncertainty y as a new independent source and nil,
+// where x may already be uncertain, otherwise returns nil and an error if y is not a real number
+// that is not negative
+//
+//	9.81 ± 0.02 = 9.81 ± 0.02
+//	(9.81 ± 0.02) ± 0.01 = 9.81 ± 0.02
+func plusMinus(x, y data.Token) (data.Token, error) {
+	ux, okX := toUncertain(x)
+	sigma, okY := y.(data.Decimal)
+
+	if !okX || !okY || !(sigma.Value() >= 0) {
+		return nil, ierr.ValueOperation(data.PlusMinus)
+	}
+
+	terms := combine(1, ux.Terms(), 0, nil)
+	if sigma.Value() != 0 {
+		terms[data.NewSource(sigma.Value())] = sigma.Value()
+	}
+
+	return data.NewUncertainToken(ux.Value(), terms), nil
+}
+
+// operateUncertain returns the value of operating x and y, where at least one of them is an Uncertain
+// and a Decimal has no uncertainty, with the uncertainty propagated to first order, and nil,
+// otherwise returns nil and an error if the operation is not defined for them:
+//
+//	(2 ± 0.1) * (3 ± 0.2) = 6.0 ± 0.5
+//	x - x = 0 ± 0
+func operateUncertain(kind data.TokenKind, x, y data.Token) (data.Token, error) {
+	ux, okX := toUncertain(x)
+	uy, okY := toUncertain(y)
+
+	if !okX || !okY {
+		return nil, ierr.ValueOperation(data.RuneMap[kind])
+	}
+
+	a, b := ux.Value(), uy.Value()
+
+	// f is the value, while dx and dy are the partial derivatives of f
+	var f, dx, dy float64
+
+	switch kind {
+	case data.AddToken:
+		f, dx, dy = a+b, 1, 1
+	case data.SubToken:
+		f, dx, dy = a-b, 1, -1
+	case data.MulToken:
+		f, dx, dy = a*b, b, a
+	case data.DivToken:
+		f, dx, dy = a/b, 1/b, -a/(b*b)
+	case data.ModToken:
+		f, dx, dy = math.Mod(a, b), 1, -math.Trunc(a/b)
+	default:
+		f = math.Pow(a, b)
+		if b != 0 {
+			dx = b * math.Pow(a, b-1)
+		}
+		if len(uy.Terms()) != 0 {
+			dy = f * math.Log(a)
+		}
+	}
+
+	return data.NewUncertainToken(f, combine(dx, ux.Terms(), dy, uy.Terms())), nil
+}
+
+// sqrtUncertain returns the square root of x with its uncertainty propagated
+func sqrtUncertain(x data.Uncertain) data.Token {
+	f := math.Sqrt(x.Value())
+	return data.NewUncertainToken(f, combine(1/(2*f), x.Terms(), 0, nil))
+}
+
+// applyUncertain returns fn of x with its uncertainty propagated through the derivative slope
+func applyUncertain(fn, slope func(x float64) float64, x data.Uncertain) data.Token {
+	return data.NewUncertainToken(fn(x.Value()), combine(slope(x.Value()), x.Terms(), 0, nil))
+}
+
+// Uncertainty returns the standard uncertainty of the value,
+// which is the root of the sum of the squares of the contributions of its sources
+func Uncertainty(u data.Uncertain) float64 {
+	var sigma float64
+	for _, term := range u.Terms() {
+		sigma = math.Hypot(sigma, term)
+	}
+	return sigma
+}
+
+// !Tool Functions
+
+// isUncertain returns true if the value token is an Uncertain, otherwise returns false
+func isUncertain(value data.Token) bool {
+	_, ok := value.(data.Uncertain)
+	return ok
+}
+
+// toUncertain returns the value token as an Uncertain and true, where a Decimal
+// has no uncertainty, otherwise returns false
+func toUncertain(value data.Token) (data.Uncertain, bool) {
+	switch value := value.(type) {
+	case data.Uncertain:
+		return value, true
+	case data.Decimal:
+		return data.NewUncertainToken(value.Value(), nil).(data.Uncertain), true
+	}
+	return data.Uncertain{}, false
+}
+
+// combine returns the contributions of each source of dx*x + dy*y,
+// where the sources that x and y share are correlated
+func combine(dx float64, x map[*data.Source]float64, dy float64, y map[*data.Source]float64) map[*data.Source]float64 {
+	terms := make(map[*data.Source]float64, len(x)+len(y))
+	for source, term := range x {
+		terms[source] += dx * term
+	}
+	for source, term := range y {
+		terms[source] += dy * term
+	}
+	return terms
+}
+
+// scaleUncertain returns the value and the contributions of u divided by d
+func scaleUncertain(u data.Uncertain, d float64) (float64, map[*data.Source]float64) {
+	return u.Value() / d, combine(1/d, u.Terms(), 0, nil)
+}
+
+// formatUncertain returns the value with its uncertainty, where the uncertainty has
+// one significant digit, or two if the first one is 1, and the value is rounded to the same place
+//
+//	12.3 ± 0.4, 9.81 ± 0.02, 1.23 ± 0.15, 120 ± 30
+func formatUncertain(u data.Uncertain) string {
+	value, sigma := u.Value(), Uncertainty(u)
+
+	if sigma == 0 || math.IsNaN(sigma) || math.IsInf(sigma, 0) || math.IsInf(value, 0) {
+		return formatFloat(value) + " ± " + formatFloat(sigma)
+	}
+
+	place := math.Floor(math.Log10(sigma))
+	if sigma/math.Pow(10, place) < 2 {
+		place--
+	}
+
+	scale := math.Pow(10, place)
+	value = math.Round(value/scale) * scale
+	sigma = math.Round(sigma/scale) * scale
+
+	decimals := int(math.Max(0, -place))
+	return strconv.FormatFloat(value, 'f', decimals, 64) + " ± " + strconv.FormatFloat(sigma, 'f', decimals, 64)
+}
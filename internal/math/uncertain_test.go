@@ -0,0 +1,68 @@
+package math
+
+import (
+	"math"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperateUncertain(t *testing.T) {
+	measure := func(value, sigma float64) data.Token {
+		u, err := plusMinus(data.NewDecimalToken(value), data.NewDecimalToken(sigma))
+		assert.Nil(t, err, "error != nil")
+		return u
+	}
+
+	a, b := measure(2, 0.1), measure(3, 0.2)
+
+	tests := []struct {
+		kind        data.TokenKind
+		x, y        data.Token
+		mean, sigma float64
+	}{
+		{kind: data.AddToken, x: a, y: b, mean: 5, sigma: math.Hypot(0.1, 0.2)},
+		{kind: data.SubToken, x: a, y: b, mean: -1, sigma: math.Hypot(0.1, 0.2)},
+		{kind: data.MulToken, x: a, y: b, mean: 6, sigma: 0.5},
+		{kind: data.DivToken, x: a, y: data.NewDecimalToken(4), mean: 0.5, sigma: 0.025},
+		{kind: data.PowToken, x: a, y: data.NewDecimalToken(3), mean: 8, sigma: 1.2},
+		{kind: data.SubToken, x: a, y: a, mean: 0, sigma: 0},
+		{kind: data.AddToken, x: a, y: a, mean: 4, sigma: 0.2},
+		{kind: data.DivToken, x: a, y: a, mean: 1, sigma: 0},
+	}
+
+	for _, tt := range tests {
+		value, err := operateUncertain(tt.kind, tt.x, tt.y)
+		assert.Nil(t, err, "error != nil")
+
+		u := value.(data.Uncertain)
+		assert.InDeltaf(t, tt.mean, u.Value(), 1e-12, "%s %c %s", Format(tt.x), data.RuneMap[tt.kind], Format(tt.y))
+		assert.InDeltaf(t, tt.sigma, Uncertainty(u), 1e-12, "%s %c %s", Format(tt.x), data.RuneMap[tt.kind], Format(tt.y))
+	}
+
+	_, err := plusMinus(data.NewDecimalToken(1), data.NewDecimalToken(-1))
+	assert.True(t, ierr.As(err, ierr.CtxValueOperation), "error != CtxValueOperation")
+
+	_, err = operateUncertain(data.AddToken, a, data.NewComplexToken(1i))
+	assert.True(t, ierr.As(err, ierr.CtxValueOperation), "error != CtxValueOperation")
+}
+
+func TestFormatUncertain(t *testing.T) {
+	tests := []struct {
+		value, sigma float64
+		want         string
+	}{
+		{value: 12.345, sigma: 0.4, want: "12.3 ± 0.4"},
+		{value: 9.81, sigma: 0.02, want: "9.81 ± 0.02"},
+		{value: 1.2345, sigma: 0.15, want: "1.23 ± 0.15"},
+		{value: 123, sigma: 33, want: "120 ± 30"},
+		{value: 5, sigma: 0, want: "5 ± 0"},
+	}
+
+	for _, tt := range tests {
+		terms := map[*data.Source]float64{data.NewSource(tt.sigma): tt.sigma}
+		assert.Equal(t, tt.want, formatUncertain(data.NewUncertainToken(tt.value, terms).(data.Uncertain)))
+	}
+}
@@ -0,0 +1,276 @@
+package math
+
+import (
+	"math"
+	"math/cmplx"
+	"strconv"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// operate returns the value token of operating x and y with the operator of the given kind and nil,
+// otherwise returns nil and an error if the operation is not defined for the values
+func operate(kind data.TokenKind, x, y data.Token, opts Options) (data.Token, error) {
+	// the dot product of two scalars is their product
+	if kind == data.ProductToken && !isArray(x) && !isArray(y) {
+		kind = data.MulToken
+	}
+
+	if kind == data.PlusMinusToken && !isArray(x) && !isArray(y) {
+		return plusMinus(x, y)
+	}
+
+	dx, okX := x.(data.Decimal)
+	dy, okY := y.(data.Decimal)
+
+	if okX && okY {
+		return operateDecimal(kind, dx.Value(), dy.Value(), opts), nil
+	}
+
+	if isArray(x) || isArray(y) {
+		return operateArray(kind, x, y, opts)
+	}
+
+	if isInterval(x) || isInterval(y) {
+		return operateInterval(kind, x, y)
+	}
+
+	if isUncertain(x) || isUncertain(y) {
+		return operateUncertain(kind, x, y)
+	}
+
+	if isQuantity(x) || isQuantity(y) {
+		return operateQuantity(kind, x, y)
+	}
+
+	if isMoney(x) || isMoney(y) {
+		return operateMoney(kind, x, y, opts)
+	}
+
+	if isDate(x) || isDate(y) {
+		return operateDate(kind, x, y)
+	}
+
+	zx, okX := toComplex(x)
+	zy, okY := toComplex(y)
+
+	if okX && okY {
+		return operateComplex(kind, zx, zy)
+	}
+
+	return nil, ierr.ValueOperation(data.RuneMap[kind])
+}
+
+// operateDecimal returns the value token of operating x and y with the operator of the given kind
+func operateDecimal(kind data.TokenKind, x, y float64, opts Options) data.Token {
+	switch kind {
+	case data.AddToken:
+		return data.NewDecimalToken(x + y)
+	case data.SubToken:
+		return data.NewDecimalToken(x - y)
+	case data.MulToken:
+		return data.NewDecimalToken(x * y)
+	case data.DivToken:
+		return data.NewDecimalToken(x / y)
+	case data.ModToken:
+		return data.NewDecimalToken(math.Mod(x, y))
+	}
+
+	pow := math.Pow(x, y)
+	if opts.Complex && math.IsNaN(pow) && !math.IsNaN(x) && !math.IsNaN(y) {
+		return data.NewComplexToken(powComplex(complex(x, 0), complex(y, 0)))
+	}
+
+	return data.NewDecimalToken(pow)
+}
+
+// sqrt returns the value token of the square root of x and nil,
+// otherwise returns nil and an error if it is not defined for the value
+func sqrt(x data.Token, opts Options) (data.Token, error) {
+	switch x := x.(type) {
+	case data.Decimal:
+		if opts.Complex && x.Value() < 0 {
+			return data.NewComplexToken(complex(0, math.Sqrt(-x.Value()))), nil
+		}
+		return data.NewDecimalToken(math.Sqrt(x.Value())), nil
+
+	case data.Complex:
+		return data.NewComplexToken(cmplx.Sqrt(x.Value())), nil
+
+	case data.Quantity:
+		return sqrtQuantity(x)
+
+	case data.Interval:
+		return data.NewIntervalToken(sqrtInterval(x.Lo(), x.Hi())), nil
+
+	case data.Uncertain:
+		return sqrtUncertain(x), nil
+
+	case data.Array:
+		return mapArray(x, func(e data.Token) (data.Token, error) {
+			return sqrt(e, opts)
+		})
+	}
+
+	return nil, ierr.ValueOperation(data.Root)
+}
+
+// Float returns the value token as a float64 and nil, where a Quantity is in its unit,
+// a Money is its amount, a Span is in seconds, an Interval is its midpoint and an Uncertain is its mean,
+// otherwise returns a zero value and an error if it is not a real number
+func Float(value data.Token) (float64, error) {
+	switch value := value.(type) {
+	case data.Decimal:
+		return value.Value(), nil
+
+	case data.Interval:
+		return value.Lo()/2 + value.Hi()/2, nil
+
+	case data.Uncertain:
+		return value.Value(), nil
+
+	case data.Span:
+		return value.Value().Seconds(), nil
+
+	case data.Money:
+		res64, _ := value.Value().Float64()
+		return res64, nil
+
+	case data.Quantity:
+		return value.Value(), nil
+
+	case data.Complex:
+		if imag(value.Value()) == 0 {
+			return real(value.Value()), nil
+		}
+	}
+
+	return 0, ierr.NotReal(Format(value))
+}
+
+// Format returns the value token as a string
+//
+//	1.5, 2i, 1-2i, 2.5 m/s, 12.50 USD, 2026-10-18, 8h35m, [1, 2], [0.09999999999999999, 0.1], 12.3 ± 0.4
+func Format(value data.Token) string {
+	switch value := value.(type) {
+	case data.Decimal:
+		return formatFloat(value.Value())
+
+	case data.Complex:
+		return formatComplex(value.Value())
+
+	case data.Quantity:
+		return formatQuantity(value)
+
+	case data.Money:
+		return formatMoney(value)
+
+	case data.Moment:
+		return value.Value().Format(value.Layout())
+
+	case data.Span:
+		return formatDuration(value.Value())
+
+	case data.Array:
+		return formatArray(value)
+
+	case data.Interval:
+		return formatInterval(value)
+
+	case data.Uncertain:
+		return formatUncertain(value)
+	}
+
+	return string(data.RuneMap[value.Kind()])
+}
+
+// !Tool Functions
+
+// isNaN returns true if the value token is "not a number", otherwise returns false
+func isNaN(value data.Token) bool {
+	switch value := value.(type) {
+	case data.Decimal:
+		return math.IsNaN(value.Value())
+	case data.Complex:
+		return cmplx.IsNaN(value.Value())
+	case data.Quantity:
+		return math.IsNaN(value.Value())
+	case data.Interval:
+		return math.IsNaN(value.Lo()) || math.IsNaN(value.Hi())
+	case data.Uncertain:
+		return math.IsNaN(value.Value()) || math.IsNaN(Uncertainty(value))
+	case data.Array:
+		for _, e := range value.Value() {
+			if isNaN(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isInf returns true if the value token is any type of infinity, otherwise returns false
+func isInf(value data.Token) bool {
+	switch value := value.(type) {
+	case data.Decimal:
+		return math.IsInf(value.Value(), 0)
+	case data.Complex:
+		return cmplx.IsInf(value.Value())
+	case data.Quantity:
+		return math.IsInf(value.Value(), 0)
+	case data.Interval:
+		return math.IsInf(value.Lo(), 0) || math.IsInf(value.Hi(), 0)
+	case data.Uncertain:
+		return math.IsInf(value.Value(), 0) || math.IsInf(Uncertainty(value), 0)
+	case data.Array:
+		for _, e := range value.Value() {
+			if isInf(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// toFloats returns the value tokens as float64 and nil, where an Array is its elements,
+// otherwise returns nil and an error if any of them is not a real number
+func toFloats(values []data.Token) ([]float64, error) {
+	xs := make([]float64, 0, len(values))
+
+	for _, value := range values {
+		for _, e := range flatten(value) {
+			x, err := toFloat(e)
+			if err != nil {
+				return nil, err
+			}
+			xs = append(xs, x)
+		}
+	}
+
+	return xs, nil
+}
+
+// toFloat returns the value token as a float64 and nil, otherwise returns a zero value
+// and an error if it is not a real number, it has a dimension or it is money, a date, a duration,
+// an interval or an uncertain value, whose bounds or uncertainty would be lost
+func toFloat(value data.Token) (float64, error) {
+	switch value := value.(type) {
+	case data.Quantity:
+		return 0, ierr.DimensionMismatch(value.Unit().Dim.String(), data.Dimension{}.String())
+	case data.Money, data.Moment, data.Span, data.Interval, data.Uncertain:
+		return 0, ierr.ValueOperation(data.Func)
+	}
+	return Float(value)
+}
+
+// isQuantity returns true if the value token is a Quantity, otherwise returns false
+func isQuantity(value data.Token) bool {
+	_, ok := value.(data.Quantity)
+	return ok
+}
+
+// formatFloat returns x as the shortest string that represents it
+func formatFloat(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
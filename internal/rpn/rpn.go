@@ -0,0 +1,285 @@
+package rpn
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/doubly"
+)
+
+// arity is the separator of a function and the number of arguments it takes from the stack
+//
+//	1 2 3 max:3
+const arity = ':'
+
+var (
+	left  = data.NewSymbolToken(data.LeftToken)
+	right = data.NewSymbolToken(data.RightToken)
+	comma = data.NewSymbolToken(data.CommaToken)
+)
+
+// Item represents an element of an expression in Reverse Polish Notation, which is a value,
+// or an operator or a function with the number of operands it takes from the stack
+type Item struct {
+	Token data.Token
+	Args  int
+}
+
+// Parse returns the items of an expression in Reverse Polish Notation separated by spaces and nil,
+// where a negative number is zero minus it, and a function that has not a fixed number
+// of arguments is written with it after ':', otherwise returns nil and an error
+//
+//	3 4 + 2 * => (3 + 4) * 2
+//	1 2 3 max:3 √ => √max(1, 2, 3)
+func Parse(expression string) ([]Item, error) {
+	var items []Item
+
+	for _, f := range fields(expression) {
+		field := f.text
+
+		switch r, size := utf8.DecodeRuneInString(field); {
+		case field == "mod":
+			items = append(items, Item{Token: data.NewSymbolToken(data.ModToken), Args: 2})
+
+		case size == len(field) && isOperator(r):
+			items = append(items, newOperator(data.TokenKindMap[r]))
+
+		case r == data.Pi && size == len(field):
+			items = append(items, Item{Token: data.NewSymbolToken(data.PiToken)})
+
+		case data.IsDecimal(r):
+			if i := strings.IndexFunc(field, isNotDecimal); i >= 0 {
+				return nil, ierr.RuneUnknown([]rune(field[i:])[0], f.i+i)
+			}
+			items = append(items, Item{Token: data.NewNumberToken(field)})
+
+		case r == data.Sub && len(field) > size && data.IsDecimal(rune(field[size])):
+			if i := strings.IndexFunc(field[size:], isNotDecimal); i >= 0 {
+				return nil, ierr.RuneUnknown([]rune(field[size+i:])[0], f.i+size+i)
+			}
+
+			items = append(items,
+				Item{Token: data.NewNumberToken("0")},
+				Item{Token: data.NewNumberToken(field[size:])},
+				Item{Token: data.NewSymbolToken(data.SubToken), Args: 2},
+			)
+
+		case data.IsLetter(r):
+			item, err := newName(field)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+
+		default:
+			return nil, ierr.RuneUnknown(r, f.i)
+		}
+	}
+
+	if len(items) == 0 {
+		return nil, ierr.EmptyField
+	}
+	return items, nil
+}
+
+// String returns the items written in Reverse Polish Notation separated by spaces
+//
+//	3 4 + 2 *
+func String(items []Item) string {
+	texts := make([]string, len(items))
+	for i, item := range items {
+		texts[i] = item.String()
+	}
+	return strings.Join(texts, string(data.Gap))
+}
+
+// ToList returns the items in Reverse Polish Notation as a list of tokens in infix notation and nil,
+// where each operation, including a square root, is wrapped in parentheses, so it can be analysed and calculated,
+// otherwise returns nil and an error if an operator has not enough operands or there are operands left
+//
+//	3 4 + 2 * => ((3+4)*2)
+func ToList(items []Item) (*doubly.Doubly, error) {
+	var stack [][]data.Token
+
+	for _, item := range items {
+		if item.Args == 0 {
+			stack = append(stack, []data.Token{item.Token})
+			continue
+		}
+
+		if len(stack) < item.Args {
+			return nil, ierr.StackUnderflow(item.String())
+		}
+
+		operands := stack[len(stack)-item.Args:]
+		stack = stack[:len(stack)-item.Args]
+		stack = append(stack, apply(item, operands))
+	}
+
+	if len(stack) == 0 {
+		return nil, ierr.EmptyField
+	}
+
+	if len(stack) > 1 {
+		return nil, ierr.LeftoverOperands(len(stack) - 1)
+	}
+
+	list := doubly.New()
+	for _, token := range stack[0] {
+		list.PushBack(token)
+	}
+	return list, nil
+}
+
+// !Item Methods
+
+// String returns the item as it is written in Reverse Polish Notation
+func (i Item) String() string {
+	switch token := i.Token.(type) {
+	case data.Number:
+		return token.Value()
+
+	case data.Word:
+		name := token.Value()
+		if spelled, ok := data.SeriesName(name); ok {
+			name = spelled
+		}
+
+		if token.Kind() == data.FuncToken && !isFixed(name) {
+			return name + string(arity) + strconv.Itoa(i.Args)
+		}
+		return name
+	}
+
+	if i.Token.Kind() == data.ModToken {
+		return "mod"
+	}
+	return string(data.RuneMap[i.Token.Kind()])
+}
+
+// !Tool Functions
+
+// field represents a text of the expression and the index where it starts
+type field struct {
+	text string
+	i    int
+}
+
+// fields returns the texts of the expression separated by spaces
+func fields(expression string) []field {
+	var fs []field
+
+	start := -1
+	for i, r := range expression + string(data.Gap) {
+		switch {
+		case !unicode.IsSpace(r) && start < 0:
+			start = i
+		case unicode.IsSpace(r) && start >= 0:
+			fs = append(fs, field{text: expression[start:i], i: start})
+			start = -1
+		}
+	}
+	return fs
+}
+
+// isNotDecimal returns true if the rune is not a digit or a dot
+func isNotDecimal(r rune) bool {
+	return !data.IsDecimal(r)
+}
+
+// isOperator returns true if the rune is an operator that takes its operands from the stack
+func isOperator(r rune) bool {
+	kind, ok := data.TokenKindMap[r]
+	if !ok {
+		return false
+	}
+
+	switch kind {
+	case data.LeftToken, data.RightToken, data.CommaToken, data.PiToken:
+		return false
+	}
+	return true
+}
+
+// newOperator returns the item of the operator of the given kind,
+// which takes one operand if it is a square root and two otherwise
+func newOperator(kind data.TokenKind) Item {
+	if kind == data.RootToken {
+		return Item{Token: data.NewSymbolToken(kind), Args: 1}
+	}
+	return Item{Token: data.NewSymbolToken(kind), Args: 2}
+}
+
+// newName returns the item of a builtin function, with the number of arguments after ':'
+// unless it has a fixed one, or a variable, otherwise returns an error
+func newName(field string) (Item, error) {
+	name, n, hasArity := strings.Cut(field, string(arity))
+
+	for _, r := range name {
+		if !data.IsWord(r) {
+			return Item{}, ierr.NameMisspelled(field)
+		}
+	}
+
+	arities, isBuiltin := data.BuiltinMap[name]
+	if !isBuiltin {
+		if hasArity {
+			return Item{}, ierr.NameUnknown(name)
+		}
+		return Item{Token: data.NewWordToken(data.VarToken, name)}, nil
+	}
+
+	if !hasArity {
+		if !isFixed(name) {
+			return Item{}, ierr.NameMisspelled(field)
+		}
+		return Item{Token: data.NewWordToken(data.FuncToken, name), Args: arities.Min}, nil
+	}
+
+	args, err := strconv.Atoi(n)
+	if err != nil {
+		return Item{}, ierr.NameMisspelled(field)
+	}
+
+	if !arities.Accepts(args) {
+		return Item{}, ierr.ArgumentCount(name, args)
+	}
+	return Item{Token: data.NewWordToken(data.FuncToken, name), Args: args}, nil
+}
+
+// isFixed returns true if the builtin function has a fixed number of arguments, otherwise returns false
+func isFixed(name string) bool {
+	arities := data.BuiltinMap[name]
+	return arities.Min == arities.Max
+}
+
+// apply returns the tokens of the item applied to its operands in infix notation
+func apply(item Item, operands [][]data.Token) []data.Token {
+	switch item.Token.Kind() {
+	case data.FuncToken:
+		tokens := []data.Token{item.Token, left}
+		for i, operand := range operands {
+			if i > 0 {
+				tokens = append(tokens, comma)
+			}
+			tokens = append(tokens, operand...)
+		}
+		return append(tokens, right)
+
+	case data.RootToken:
+		// the square root is wrapped too, so it is done before the operator of its parent
+		tokens := []data.Token{left, item.Token, left}
+		tokens = append(tokens, operands[0]...)
+		return append(tokens, right, right)
+	}
+
+	tokens := []data.Token{left}
+	tokens = append(tokens, operands[0]...)
+	tokens = append(tokens, item.Token)
+	tokens = append(tokens, operands[1]...)
+	return append(tokens, right)
+}
@@ -0,0 +1,67 @@
+package rpn
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	items, err := Parse("3 -4.5 + π × √ 1 2 max:2 mod x dot")
+	assert.Nil(t, err, "error != nil")
+	assert.Equal(t, "3 0 4.5 - + π * √ 1 2 max:2 mod x dot", String(items))
+
+	assert.Equal(t, []int{0, 0, 0, 2, 2, 0, 2, 1, 0, 0, 2, 2, 0, 2}, argsOf(items))
+	assert.Equal(t, data.VarToken, items[12].Token.Kind())
+	assert.Equal(t, data.FuncToken, items[13].Token.Kind())
+
+	_, err = Parse("1 2 $")
+	assert.ErrorContains(t, err, "'$' in index: 4")
+
+	_, err = Parse("1 2.a")
+	assert.ErrorContains(t, err, "'a' in index: 4")
+
+	_, err = Parse(" \t ")
+	assert.ErrorIs(t, err, ierr.EmptyField)
+}
+
+func TestToList(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+		as   ierr.KindOf
+	}{
+		{expr: "3 4 + 2 *", want: "((n+n)*n)"},
+		{expr: "2 √ 3 ^", want: "((√(n))^n)"},
+		{expr: "2 3 √ ^", want: "(n^(√(n)))"},
+		{expr: "1 2 3 max:3 2 /", want: "(f(n,n,n)/n)"},
+		{expr: "π", want: "π"},
+		{expr: "+", as: ierr.CtxStackUnderflow},
+		{expr: "1 2 3 dot", as: ierr.CtxLeftoverOperands},
+	}
+
+	for _, tt := range tests {
+		items, err := Parse(tt.expr)
+		assert.Nilf(t, err, "%s: error != nil", tt.expr)
+
+		list, err := ToList(items)
+		if tt.as != "" {
+			assert.Truef(t, ierr.As(err, tt.as), "%s: error != %v", tt.expr, tt.as)
+			continue
+		}
+
+		assert.Nilf(t, err, "%s: error != nil", tt.expr)
+		assert.Equalf(t, tt.want, toString(list), "%s", tt.expr)
+	}
+}
+
+// argsOf returns the number of operands of each item
+func argsOf(items []Item) []int {
+	args := make([]int, len(items))
+	for i, item := range items {
+		args[i] = item.Args
+	}
+	return args
+}
@@ -0,0 +1,127 @@
+package rpn
+
+import (
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/doubly"
+)
+
+// the precedences of the operators, which are done from left to right like the calculator,
+// so 2^3^2 is (2^3)^2
+const (
+	precAdd = iota + 1
+	precMul
+	precPlusMinus
+	precPow
+	precRoot
+)
+
+// call represents a function waiting for its RightToken and the number of its arguments
+type call struct {
+	token data.Token
+	args  int
+}
+
+// FromList returns the items in Reverse Polish Notation of an analysed list of tokens in infix notation
+// and nil, using the shunting-yard algorithm, otherwise returns nil and an error
+// if a token can not be written in Reverse Polish Notation, like an array or a unit
+//
+//	(3 + 4) * 2 => 3 4 + 2 *
+func FromList(list *doubly.Doubly) ([]Item, error) {
+	var items []Item
+	var ops []data.Token
+	var calls []call
+
+	// pop moves the operators of the stack to the items while 'cond' is true
+	pop := func(cond func(op data.Token) bool) {
+		for len(ops) > 0 && cond(ops[len(ops)-1]) {
+			items = append(items, newOperator(ops[len(ops)-1].Kind()))
+			ops = ops[:len(ops)-1]
+		}
+	}
+
+	notLeft := func(op data.Token) bool {
+		return op.Kind() != data.LeftToken
+	}
+
+	isCall := false
+
+	for temp := list.Head(); temp != nil; temp = temp.Next() {
+		token := temp.Token()
+
+		switch kind := token.Kind(); kind {
+		case data.NumToken, data.PiToken, data.VarToken:
+			items = append(items, Item{Token: token})
+
+		case data.FuncToken:
+			calls = append(calls, call{token: token, args: 1})
+			isCall = true
+
+		case data.LeftToken:
+			if !isCall {
+				calls = append(calls, call{})
+			}
+			ops = append(ops, token)
+			isCall = false
+
+		case data.CommaToken:
+			pop(notLeft)
+			if len(calls) > 0 {
+				calls[len(calls)-1].args++
+			}
+
+		case data.RightToken:
+			pop(notLeft)
+			if len(ops) == 0 || len(calls) == 0 {
+				return nil, ierr.IncompleteRight
+			}
+			ops = ops[:len(ops)-1]
+
+			c := calls[len(calls)-1]
+			calls = calls[:len(calls)-1]
+			if c.token != nil {
+				items = append(items, Item{Token: c.token, Args: c.args})
+			}
+
+		case data.RootToken:
+			ops = append(ops, token)
+
+		default:
+			prec := precedenceOf(kind)
+			if prec == 0 {
+				return nil, ierr.ValueOperation(data.RuneMap[kind])
+			}
+
+			pop(func(op data.Token) bool {
+				return notLeft(op) && precedenceOf(op.Kind()) >= prec
+			})
+			ops = append(ops, token)
+		}
+	}
+
+	pop(notLeft)
+	if len(ops) > 0 {
+		return nil, ierr.IncompleteLeft
+	}
+	return items, nil
+}
+
+// !Tool Functions
+
+// precedenceOf returns the precedence of the operator of the given kind,
+// otherwise returns 0 if it is not an operator
+func precedenceOf(kind data.TokenKind) int {
+	switch kind {
+	case data.AddToken, data.SubToken:
+		return precAdd
+	case data.MulToken, data.DivToken, data.ModToken, data.ProductToken:
+		return precMul
+	case data.PlusMinusToken:
+		return precPlusMinus
+	case data.PowToken:
+		return precPow
+	case data.RootToken:
+		return precRoot
+	}
+	return 0
+}
@@ -0,0 +1,52 @@
+package rpn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/analyse"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/doubly"
+	"github.com/brianlewyn/go-calculator/internal/tokenize"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromList(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "(3 + 4) * 2", want: "3 4 + 2 *"},
+		{expr: "1 - 2 - 3", want: "1 2 - 3 -"},
+		{expr: "2^3^2", want: "2 3 ^ 2 ^"},
+		{expr: "√√16", want: "16 √ √"},
+		{expr: "2^√4 * 3", want: "2 4 √ ^ 3 *"},
+		{expr: "x * -2", want: "x 0 2 - *"},
+		{expr: "f(a, g(b, c + 1))", want: "a b c 1 + g f"},
+		{expr: "max(min(1, 2), 3)", want: "1 2 min:2 3 max:2"},
+	}
+
+	for _, tt := range tests {
+		list, err := tokenize.Tokenizer(tt.expr)
+		assert.Nilf(t, err, "%s: error != nil", tt.expr)
+		assert.Nilf(t, analyse.Analyser(list), "%s: error != nil", tt.expr)
+
+		items, err := FromList(list)
+		assert.Nilf(t, err, "%s: error != nil", tt.expr)
+		assert.Equalf(t, tt.want, String(items), "%s", tt.expr)
+	}
+
+	list, _ := tokenize.Tokenizer("[1, 2]")
+	_, err := FromList(list)
+	assert.True(t, ierr.As(err, ierr.CtxValueOperation), "error != CtxValueOperation")
+}
+
+// toString returns the list of tokens written with the rune of each kind
+func toString(list *doubly.Doubly) string {
+	var b strings.Builder
+	for temp := list.Head(); temp != nil; temp = temp.Next() {
+		b.WriteRune(data.RuneMap[temp.Token().Kind()])
+	}
+	return b.String()
+}
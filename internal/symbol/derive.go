@@ -0,0 +1,177 @@
+package symbol
+
+import (
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// derivatives are the derivatives of the functions of one argument at u,
+// which are multiplied by the derivative of u by the chain rule
+var derivatives = map[string]func(u Expr) Expr{
+	"sqrt": func(u Expr) Expr { return div(NewNum(1), mul(NewNum(2), call("sqrt", u))) },
+	"exp":  func(u Expr) Expr { return call("exp", u) },
+	"ln":   func(u Expr) Expr { return div(NewNum(1), u) },
+	"log":  func(u Expr) Expr { return div(NewNum(1), mul(u, call("ln", NewNum(10)))) },
+	"sin":  func(u Expr) Expr { return call("cos", u) },
+	"cos":  func(u Expr) Expr { return Neg{X: call("sin", u)} },
+	"tan":  func(u Expr) Expr { return div(NewNum(1), pow(call("cos", u), NewNum(2))) },
+	"asin": func(u Expr) Expr { return div(NewNum(1), Root{X: sub(NewNum(1), pow(u, NewNum(2)))}) },
+	"acos": func(u Expr) Expr { return Neg{X: div(NewNum(1), Root{X: sub(NewNum(1), pow(u, NewNum(2)))})} },
+	"atan": func(u Expr) Expr { return div(NewNum(1), add(NewNum(1), pow(u, NewNum(2)))) },
+	"abs":  func(u Expr) Expr { return div(u, call("abs", u)) },
+}
+
+// Derive returns the derivative of e with respect to the variable x simplified and nil,
+// otherwise returns nil and an error if a function or an operation has no known derivative
+//
+//	d/dx x^2 * sin(x) = 2*x*sin(x) + x^2*cos(x)
+func Derive(e Expr, x string) (Expr, error) {
+	d, err := derive(e, Var(x))
+	if err != nil {
+		return nil, err
+	}
+	return Simplify(d), nil
+}
+
+// !Tool Functions
+
+// derive returns the derivative of e with respect to x without simplifying it,
+// where the derivative of an array is the array of the derivatives of its elements
+func derive(e Expr, x Var) (Expr, error) {
+	if a, ok := e.(Array); ok {
+		elems := make([]Expr, len(a.Elems))
+		for i, elem := range a.Elems {
+			du, err := derive(elem, x)
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = du
+		}
+		return Array{Elems: elems}, nil
+	}
+
+	if !dependsOn(e, x) {
+		return NewNum(0), nil
+	}
+
+	switch e := e.(type) {
+	case Var:
+		return NewNum(1), nil
+
+	case Neg:
+		du, err := derive(e.X, x)
+		return Neg{X: du}, err
+
+	case Root:
+		du, err := derive(e.X, x)
+		return div(du, mul(NewNum(2), e)), err
+
+	case Call:
+		return deriveCall(e, x)
+	}
+
+	b := e.(Binary)
+
+	du, err := derive(b.X, x)
+	if err != nil {
+		return nil, err
+	}
+
+	dv, err := derive(b.Y, x)
+	if err != nil {
+		return nil, err
+	}
+
+	u, v := b.X, b.Y
+
+	switch b.Op {
+	case data.AddToken:
+		return add(du, dv), nil
+
+	case data.SubToken:
+		return sub(du, dv), nil
+
+	case data.MulToken:
+		return add(mul(du, v), mul(u, dv)), nil
+
+	case data.DivToken:
+		return div(sub(mul(du, v), mul(u, dv)), pow(v, NewNum(2))), nil
+
+	case data.ModToken:
+		if dependsOn(v, x) {
+			return nil, ierr.NotDifferentiable("mod")
+		}
+		return du, nil
+
+	case data.ProductToken, data.PlusMinusToken:
+		return nil, ierr.NotDifferentiable(string(data.RuneMap[b.Op]))
+	}
+
+	// u^v = u^v * (v' * ln(u) + v * u'/u) is shorter if one of them is constant
+	switch {
+	case !dependsOn(v, x):
+		return mul(mul(v, pow(u, sub(v, NewNum(1)))), du), nil
+	case !dependsOn(u, x):
+		return mul(mul(b, call("ln", u)), dv), nil
+	}
+	return mul(b, add(mul(dv, call("ln", u)), div(mul(v, du), u))), nil
+}
+
+// deriveCall returns the derivative of a call to a function of one argument by the chain rule
+func deriveCall(c Call, x Var) (Expr, error) {
+	fn, ok := derivatives[c.Name]
+	if !ok || len(c.Args) != 1 {
+		return nil, ierr.NotDifferentiable(c.Name)
+	}
+
+	du, err := derive(c.Args[0], x)
+	if err != nil {
+		return nil, err
+	}
+	return mul(fn(c.Args[0]), du), nil
+}
+
+// dependsOn returns true if x appears in e, otherwise returns false
+func dependsOn(e Expr, x Var) bool {
+	switch e := e.(type) {
+	case Var:
+		return e == x
+	case Neg:
+		return dependsOn(e.X, x)
+	case Root:
+		return dependsOn(e.X, x)
+	case Binary:
+		return dependsOn(e.X, x) || dependsOn(e.Y, x)
+	case Call:
+		for _, arg := range e.Args {
+			if dependsOn(arg, x) {
+				return true
+			}
+		}
+	case Array:
+		for _, elem := range e.Elems {
+			if dependsOn(elem, x) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// add returns x + y
+func add(x, y Expr) Expr { return Binary{Op: data.AddToken, X: x, Y: y} }
+
+// sub returns x - y
+func sub(x, y Expr) Expr { return Binary{Op: data.SubToken, X: x, Y: y} }
+
+// mul returns x * y
+func mul(x, y Expr) Expr { return Binary{Op: data.MulToken, X: x, Y: y} }
+
+// div returns x / y
+func div(x, y Expr) Expr { return Binary{Op: data.DivToken, X: x, Y: y} }
+
+// pow returns x ^ y
+func pow(x, y Expr) Expr { return Binary{Op: data.PowToken, X: x, Y: y} }
+
+// call returns a call to the function name with one argument
+func call(name string, arg Expr) Expr { return Call{Name: name, Args: []Expr{arg}} }
@@ -0,0 +1,49 @@
+package symbol
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerive(t *testing.T) {
+	tests := []struct {
+		expr string
+		x    string
+		want string
+	}{
+		{expr: "x^2 * sin(x)", x: "x", want: "2*x*sin(x) + x^2*cos(x)"},
+		{expr: "x^3 - 2*x + 7", x: "x", want: "3*x^2 - 2"},
+		{expr: "√x", x: "x", want: "1/(2*√x)"},
+		{expr: "2^x", x: "x", want: "2^x*ln(2)"},
+		{expr: "x^x", x: "x", want: "x^x*(ln(x) + 1)"},
+		{expr: "sin(x) / x", x: "x", want: "(cos(x)*x - sin(x))/x^2"},
+		{expr: "x * y^2", x: "y", want: "2*x*y"},
+		{expr: "ln(x^2 + 1)", x: "x", want: "2*x/(x^2 + 1)"},
+		{expr: "-x^2", x: "x", want: "-2*x"},
+		{expr: "acos(2*x)", x: "x", want: "-2/√(1 - (2*x)^2)"},
+		{expr: "(x + 1)^-1", x: "x", want: "-(x + 1)^(-2)"},
+		{expr: "0.5 * x^2 + π", x: "x", want: "x"},
+		{expr: "avg(y, 2)", x: "x", want: "0"},
+		{expr: "[x, x^2, 3]", x: "x", want: "[1, 2*x, 0]"},
+	}
+
+	for _, tt := range tests {
+		d, err := Derive(parse(t, tt.expr), tt.x)
+		assert.Nil(t, err, "error != nil")
+		assert.Equalf(t, tt.want, d.String(), "d/d%s %s", tt.x, tt.expr)
+	}
+
+	_, err := Derive(parse(t, "avg(x, 2)"), "x")
+	assert.True(t, ierr.As(err, ierr.CtxNotDifferentiable), "error != CtxNotDifferentiable")
+
+	_, err = Derive(parse(t, "3 % x"), "x")
+	assert.True(t, ierr.As(err, ierr.CtxNotDifferentiable), "error != CtxNotDifferentiable")
+
+	_, err = Derive(parse(t, "x ± 0.1"), "x")
+	assert.True(t, ierr.As(err, ierr.CtxNotDifferentiable), "error != CtxNotDifferentiable")
+
+	_, err = Derive(parse(t, "[x, 1]·[2, 3]"), "x")
+	assert.True(t, ierr.As(err, ierr.CtxNotDifferentiable), "error != CtxNotDifferentiable")
+}
@@ -0,0 +1,270 @@
+package symbol
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// Expr represents a node of an expression tree, which is written back
+// as an expression that the calculator can solve
+type Expr interface {
+	String() string
+}
+
+// Num represents an exact number with a finite decimal expansion
+type Num struct {
+	value *big.Rat
+}
+
+// Var represents a variable or the constant π
+type Var string
+
+// Neg represents the negation of X
+type Neg struct {
+	X Expr
+}
+
+// Binary represents the operation of X and Y with the operator of the given kind:
+//
+//	+, -, *, /, %, ·, ±, ^
+type Binary struct {
+	Op   data.TokenKind
+	X, Y Expr
+}
+
+// Root represents the square root of X
+type Root struct {
+	X Expr
+}
+
+// Call represents a call to a function
+type Call struct {
+	Name string
+	Args []Expr
+}
+
+// Array represents an array of elements, which can be arrays themselves
+type Array struct {
+	Elems []Expr
+}
+
+// Pi is the constant π
+const Pi = Var(data.Pi)
+
+// the precedences of the nodes, where a node is wrapped in parentheses
+// if it is an operand of an operator with a greater one
+const (
+	precAdd = iota + 1
+	precMul
+	precPlusMinus
+	precPow
+	precAtom
+)
+
+// !Functions to create an instance
+
+// NewNum returns a Num of the given integer
+func NewNum(x int64) Num {
+	return Num{value: big.NewRat(x, 1)}
+}
+
+// newNum returns a Num of r and true if it has a finite decimal expansion, otherwise returns false
+func newNum(r *big.Rat) (Num, bool) {
+	if _, ok := decimals(r); !ok {
+		return Num{}, false
+	}
+	return Num{value: r}, true
+}
+
+// !Num Methods
+
+// Value returns the exact number
+func (n Num) Value() *big.Rat { return new(big.Rat).Set(n.value) }
+
+// Sign returns -1 if the number is negative, 0 if it is zero and 1 if it is positive
+func (n Num) Sign() int { return n.value.Sign() }
+
+// IsInt returns true if the number is an integer, otherwise returns false
+func (n Num) IsInt() bool { return n.value.IsInt() }
+
+// String returns the number in decimal notation
+func (n Num) String() string {
+	digits, _ := decimals(n.value)
+	return n.value.FloatString(digits)
+}
+
+// !String Methods
+
+// String returns the variable name
+func (v Var) String() string { return string(v) }
+
+// String returns the negation, where X is wrapped in parentheses if it is an addition
+func (n Neg) String() string {
+	return string(data.Sub) + wrap(n.X, precAdd+1)
+}
+
+// String returns the operation with the least parentheses that keep its meaning,
+// where the operand on the right is only written without them if it is an operation
+// with the same associative operator, so 2*(x mod 3) is not written as 2*x mod 3
+func (b Binary) String() string {
+	prec := precedenceOf(b.Op)
+
+	x := wrap(b.X, prec)
+	if isNegative(b.X) && prec > precAdd {
+		x = "(" + b.X.String() + ")"
+	}
+
+	y := wrap(b.Y, prec+1)
+	switch {
+	case isNegative(b.Y):
+		y = "(" + b.Y.String() + ")"
+	case prec == precPow && !isAtom(b.Y):
+		y = "(" + b.Y.String() + ")"
+	case isAssociative(b.Op, b.Y):
+		y = b.Y.String()
+	}
+
+	switch b.Op {
+	case data.AddToken, data.SubToken, data.PlusMinusToken:
+		return x + " " + string(data.RuneMap[b.Op]) + " " + y
+	case data.ModToken:
+		return x + " mod " + y
+	}
+	return x + string(data.RuneMap[b.Op]) + y
+}
+
+// String returns the square root, where X is wrapped in parentheses unless it is a number or a variable
+func (r Root) String() string {
+	if isAtom(r.X) && !isNegative(r.X) {
+		return string(data.Root) + r.X.String()
+	}
+	return string(data.Root) + "(" + r.X.String() + ")"
+}
+
+// String returns the call with its arguments separated by commas
+func (c Call) String() string {
+	args := make([]string, len(c.Args))
+	for i, arg := range c.Args {
+		args[i] = arg.String()
+	}
+	return c.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// String returns the array with its elements separated by commas between brackets
+func (a Array) String() string {
+	elems := make([]string, len(a.Elems))
+	for i, elem := range a.Elems {
+		elems[i] = elem.String()
+	}
+	return string(data.LeftBracket) + strings.Join(elems, ", ") + string(data.RightBracket)
+}
+
+// !Tool Functions
+
+// precedence returns the precedence of the node
+func precedence(e Expr) int {
+	switch e := e.(type) {
+	case Binary:
+		return precedenceOf(e.Op)
+	case Neg:
+		return precAdd
+	case Num:
+		if e.Sign() < 0 {
+			return precAdd
+		}
+	}
+	return precAtom
+}
+
+// precedenceOf returns the precedence of the operator of the given kind
+func precedenceOf(op data.TokenKind) int {
+	switch op {
+	case data.AddToken, data.SubToken:
+		return precAdd
+	case data.PlusMinusToken:
+		return precPlusMinus
+	case data.PowToken:
+		return precPow
+	}
+	return precMul
+}
+
+// wrap returns the node wrapped in parentheses if its precedence is less than prec
+func wrap(e Expr, prec int) string {
+	if precedence(e) < prec {
+		return "(" + e.String() + ")"
+	}
+	return e.String()
+}
+
+// isAssociative returns true if the operator is + or * and the node is an operation
+// with the same one, which does not start with a modulo in the case of *,
+// since x*(y mod 2*z) is not x*y mod 2*z, otherwise returns false
+func isAssociative(op data.TokenKind, e Expr) bool {
+	b, ok := e.(Binary)
+	if !ok || b.Op != op {
+		return false
+	}
+	return op == data.AddToken || op == data.MulToken && !startsWithMod(b.X)
+}
+
+// startsWithMod returns true if the node is written starting with a modulo
+// that is not wrapped in parentheses, otherwise returns false
+func startsWithMod(e Expr) bool {
+	b, ok := e.(Binary)
+	if !ok || precedenceOf(b.Op) != precMul {
+		return false
+	}
+	return b.Op == data.ModToken || startsWithMod(b.X)
+}
+
+// isAtom returns true if the node is a number, a variable, a call or an array, otherwise returns false
+func isAtom(e Expr) bool {
+	switch e.(type) {
+	case Num, Var, Call, Array:
+		return true
+	}
+	return false
+}
+
+// isNegative returns true if the node is a negation or a negative number, otherwise returns false
+func isNegative(e Expr) bool {
+	switch e := e.(type) {
+	case Neg:
+		return true
+	case Num:
+		return e.Sign() < 0
+	}
+	return false
+}
+
+// decimals returns the number of decimals of r and true if it has a finite decimal expansion,
+// which is the case if its denominator only has the factors 2 and 5, otherwise returns false
+func decimals(r *big.Rat) (int, bool) {
+	d := new(big.Int).Set(r.Denom())
+	n2 := removeFactor(d, 2)
+	n5 := removeFactor(d, 5)
+
+	if !d.IsInt64() || d.Int64() != 1 {
+		return 0, false
+	}
+
+	if n2 > n5 {
+		return n2, true
+	}
+	return n5, true
+}
+
+// removeFactor divides d by the factor while it is divisible and returns how many times it was
+func removeFactor(d *big.Int, factor int64) int {
+	f, mod := big.NewInt(factor), new(big.Int)
+
+	n := 0
+	for mod.Mod(d, f).Sign() == 0 {
+		d.Quo(d, f)
+		n++
+	}
+	return n
+}
@@ -0,0 +1,159 @@
+package symbol
+
+import (
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// Style represents the way Format writes an expression tree
+type Style struct {
+	// Full wraps each operation inside another one in parentheses,
+	// otherwise only the ones that keep its meaning are written
+	Full bool
+
+	// Unicode writes the operators ×, ÷ and √, otherwise *, / and sqrt
+	Unicode bool
+}
+
+// Format returns the expression tree written with the given style, with a space around
+// each operator but ^, where a subtraction from zero is a negation and sqrt is a square root,
+// so formatting the expression written again returns the same one
+//
+//	(0 - x)*sqrt(y) => -x * sqrt(y)
+func Format(e Expr, style Style) string {
+	return style.write(canonical(e))
+}
+
+// !Style Methods
+
+// write returns the node written with the style
+func (s Style) write(e Expr) string {
+	switch e := e.(type) {
+	case Neg:
+		return string(data.Sub) + s.operand(e.X, precAdd+1, s.Full && isOperation(e.X))
+
+	case Binary:
+		return s.binary(e)
+
+	case Root:
+		if !s.Unicode {
+			return "sqrt(" + s.write(e.X) + ")"
+		}
+
+		if isAtom(e.X) && !isNegative(e.X) {
+			return string(data.Root) + s.write(e.X)
+		}
+		return string(data.Root) + "(" + s.write(e.X) + ")"
+
+	case Call:
+		args := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = s.write(arg)
+		}
+		return e.Name + "(" + strings.Join(args, ", ") + ")"
+
+	case Array:
+		elems := make([]string, len(e.Elems))
+		for i, elem := range e.Elems {
+			elems[i] = s.write(elem)
+		}
+		return string(data.LeftBracket) + strings.Join(elems, ", ") + string(data.RightBracket)
+	}
+
+	return e.String()
+}
+
+// binary returns the operation written with the style, where the operand on the right
+// is wrapped in parentheses if it has the same precedence, since the operations are done
+// from left to right
+func (s Style) binary(b Binary) string {
+	prec := precedenceOf(b.Op)
+
+	_, isRoot := b.X.(Root)
+	x := s.operand(b.X, prec, s.Full && (isOperation(b.X) || isRoot && prec == precPow && s.Unicode) ||
+		isNegative(b.X) && prec > precAdd)
+
+	y := s.operand(b.Y, prec+1, s.Full && isOperation(b.Y) || isNegative(b.Y) || prec == precPow && !s.isAtom(b.Y))
+
+	if b.Op == data.PowToken {
+		return x + string(data.Pow) + y
+	}
+	return x + " " + s.operator(b.Op) + " " + y
+}
+
+// operand returns the node written with the style, which is wrapped in parentheses
+// if its precedence is less than prec or 'force' is true
+func (s Style) operand(e Expr, prec int, force bool) string {
+	if precedence(e) < prec || force {
+		return "(" + s.write(e) + ")"
+	}
+	return s.write(e)
+}
+
+// isAtom returns true if the node is written as a number, a variable, a call or an array,
+// like a square root without Unicode, otherwise returns false
+func (s Style) isAtom(e Expr) bool {
+	if _, ok := e.(Root); ok && !s.Unicode {
+		return true
+	}
+	return isAtom(e)
+}
+
+// operator returns the operator of the given kind written with the style
+func (s Style) operator(op data.TokenKind) string {
+	switch {
+	case op == data.ModToken:
+		return "mod"
+	case op == data.MulToken && s.Unicode:
+		return string(data.Times)
+	case op == data.DivToken && s.Unicode:
+		return string(data.Obelus)
+	}
+	return string(data.RuneMap[op])
+}
+
+// !Tool Functions
+
+// canonical returns the node with each subtraction from zero as a negation
+// and each call to sqrt as a square root, which is how they are read back
+func canonical(e Expr) Expr {
+	switch e := e.(type) {
+	case Neg:
+		return Neg{X: canonical(e.X)}
+
+	case Binary:
+		if x, ok := e.X.(Num); ok && e.Op == data.SubToken && x.Sign() == 0 {
+			return Neg{X: canonical(e.Y)}
+		}
+		return Binary{Op: e.Op, X: canonical(e.X), Y: canonical(e.Y)}
+
+	case Root:
+		return Root{X: canonical(e.X)}
+
+	case Call:
+		if e.Name == "sqrt" && len(e.Args) == 1 {
+			return Root{X: canonical(e.Args[0])}
+		}
+
+		args := make([]Expr, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = canonical(arg)
+		}
+		return Call{Name: e.Name, Args: args}
+
+	case Array:
+		elems := make([]Expr, len(e.Elems))
+		for i, elem := range e.Elems {
+			elems[i] = canonical(elem)
+		}
+		return Array{Elems: elems}
+	}
+	return e
+}
+
+// isOperation returns true if the node is a binary operation or a negation, otherwise returns false
+func isOperation(e Expr) bool {
+	_, ok := e.(Binary)
+	return ok || isNegative(e)
+}
@@ -0,0 +1,49 @@
+package symbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		expr    string
+		ascii   string
+		unicode string
+		full    string
+	}{
+		{expr: "1+2*3", ascii: "1 + 2 * 3", unicode: "1 + 2 × 3", full: "1 + (2 × 3)"},
+		{expr: "(1+2)*3", ascii: "(1 + 2) * 3", unicode: "(1 + 2) × 3", full: "(1 + 2) × 3"},
+		{expr: "x/(2*y)", ascii: "x / (2 * y)", unicode: "x ÷ (2 × y)", full: "x ÷ (2 × y)"},
+		{expr: "1 - (2 - 3)", ascii: "1 - (2 - 3)", unicode: "1 - (2 - 3)", full: "1 - (2 - 3)"},
+		{expr: "a + (b + c)", ascii: "a + (b + c)", unicode: "a + (b + c)", full: "a + (b + c)"},
+		{expr: "2^3^2", ascii: "2^3^2", unicode: "2^3^2", full: "(2^3)^2"},
+		{expr: "2^(3^2)", ascii: "2^(3^2)", unicode: "2^(3^2)", full: "2^(3^2)"},
+		{expr: "√x^2", ascii: "sqrt(x)^2", unicode: "√x^2", full: "(√x)^2"},
+		{expr: "sqrt(x+1)", ascii: "sqrt(x + 1)", unicode: "√(x + 1)", full: "√(x + 1)"},
+		{expr: "√√16", ascii: "sqrt(sqrt(16))", unicode: "√(√16)", full: "√(√16)"},
+		{expr: "-x^2", ascii: "-x^2", unicode: "-x^2", full: "-(x^2)"},
+		{expr: "(-x)^2", ascii: "(-x)^2", unicode: "(-x)^2", full: "(-x)^2"},
+		{expr: "x * -2", ascii: "x * (-2)", unicode: "x × (-2)", full: "x × (-2)"},
+		{expr: "-(-x)", ascii: "-(-x)", unicode: "-(-x)", full: "-(-x)"},
+		{expr: "-x + 1", ascii: "-x + 1", unicode: "-x + 1", full: "(-x) + 1"},
+		{expr: "7 % 2.50", ascii: "7 mod 2.5", unicode: "7 mod 2.5", full: "7 mod 2.5"},
+		{expr: "2×π ÷ max(y,-x)", ascii: "2 * π / max(y, -x)", unicode: "2 × π ÷ max(y, -x)", full: "(2 × π) ÷ max(y, -x)"},
+		{expr: "2*9.81±0.02", ascii: "2 * 9.81 ± 0.02", unicode: "2 × 9.81 ± 0.02", full: "2 × (9.81 ± 0.02)"},
+		{expr: "[1,x^2]·[3,4]", ascii: "[1, x^2] · [3, 4]", unicode: "[1, x^2] · [3, 4]", full: "[1, x^2] · [3, 4]"},
+	}
+
+	for _, tt := range tests {
+		e := parse(t, tt.expr)
+
+		assert.Equalf(t, tt.ascii, Format(e, Style{}), "%s", tt.expr)
+		assert.Equalf(t, tt.unicode, Format(e, Style{Unicode: true}), "%s", tt.expr)
+		assert.Equalf(t, tt.full, Format(e, Style{Full: true, Unicode: true}), "%s", tt.expr)
+
+		for _, style := range []Style{{}, {Unicode: true}, {Full: true}, {Full: true, Unicode: true}} {
+			s := Format(e, style)
+			assert.Equalf(t, s, Format(parse(t, s), style), "%s: %+v", tt.expr, style)
+		}
+	}
+}
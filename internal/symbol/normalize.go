@@ -0,0 +1,376 @@
+package symbol
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// factor represents a base raised to an exponent inside a term
+type factor struct {
+	base, exp Expr
+}
+
+// term represents a number times a product of factors inside a sum
+type term struct {
+	coeff   *big.Rat
+	factors []factor
+}
+
+// Normalize returns e simplified in a canonical form, where the like terms of each sum
+// and the like factors of each product are collected, and both are sorted, which is done again
+// until nothing changes, so two equivalent expressions written in different ways have the same form
+// and normalizing it returns it unchanged:
+//
+//	x*1 + 0 + (2+3)*x = 6*x
+//	y*x + 2*x*y - x^2/x = 3*x*y - x
+//	1 + x + x^2 = x^2 + x + 1
+func Normalize(e Expr) Expr {
+	return fixed(e, func(e Expr) Expr {
+		return build(termsOf(Simplify(e)))
+	})
+}
+
+// !Tool Functions
+
+// termsOf returns the collected terms of the sum e
+func termsOf(e Expr) []term {
+	switch e := e.(type) {
+	case Num:
+		return collect([]term{{coeff: e.Value()}})
+
+	case Neg:
+		return scale(termsOf(e.X), big.NewRat(-1, 1))
+
+	case Root:
+		return opaque(root(Normalize(e.X)))
+
+	case Call:
+		args := make([]Expr, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = Normalize(arg)
+		}
+		return single(factor{base: Call{Name: e.Name, Args: args}, exp: NewNum(1)})
+
+	case Array:
+		elems := make([]Expr, len(e.Elems))
+		for i, elem := range e.Elems {
+			elems[i] = Normalize(elem)
+		}
+		return single(factor{base: Array{Elems: elems}, exp: NewNum(1)})
+
+	case Binary:
+		return binaryTerms(e)
+	}
+	return single(factor{base: e, exp: NewNum(1)})
+}
+
+// binaryTerms returns the collected terms of an operation, where a sum inside a product
+// is not expanded but is a factor
+func binaryTerms(b Binary) []term {
+	switch b.Op {
+	case data.AddToken:
+		return collect(append(termsOf(b.X), termsOf(b.Y)...))
+
+	case data.SubToken:
+		return collect(append(termsOf(b.X), scale(termsOf(b.Y), big.NewRat(-1, 1))...))
+
+	case data.MulToken:
+		return collect([]term{multiply(asTerm(termsOf(b.X)), asTerm(termsOf(b.Y)))})
+
+	case data.DivToken:
+		return collect([]term{multiply(asTerm(termsOf(b.X)), invert(asTerm(termsOf(b.Y))))})
+
+	case data.PowToken:
+		return powTerms(termsOf(b.X), Normalize(b.Y))
+	}
+
+	// a modulo, a dot product or an uncertainty is a factor that is not split,
+	// since its operands are not terms of the product
+	return opaque(simplifyBinary(b.Op, Normalize(b.X), Normalize(b.Y)))
+}
+
+// powTerms returns the terms of ts raised to exp, where the power of one term
+// with an integer exponent is the power of its number and of each of its factors
+func powTerms(ts []term, exp Expr) []term {
+	n, ok := exp.(Num)
+	if len(ts) != 1 || !ok || !n.IsInt() {
+		return single(factor{base: build(ts), exp: exp})
+	}
+
+	coeff, ok := foldPow(ts[0].coeff, n.value)
+	if !ok {
+		return single(factor{base: build(ts), exp: exp})
+	}
+
+	t := term{coeff: coeff.value}
+	for _, f := range ts[0].factors {
+		t.factors = append(t.factors, factor{base: f.base, exp: Simplify(mul(f.exp, n))})
+	}
+	return collect([]term{t})
+}
+
+// opaque returns the terms of a node that is not split into factors, which is a number if it was folded
+func opaque(e Expr) []term {
+	if n, ok := e.(Num); ok {
+		return collect([]term{{coeff: n.Value()}})
+	}
+	return single(factor{base: e, exp: NewNum(1)})
+}
+
+// single returns the terms of a single factor
+func single(f factor) []term {
+	return []term{{coeff: big.NewRat(1, 1), factors: []factor{f}}}
+}
+
+// asTerm returns ts as one term, where a sum of several terms is a factor
+func asTerm(ts []term) term {
+	switch len(ts) {
+	case 0:
+		return term{coeff: new(big.Rat)}
+	case 1:
+		return ts[0]
+	}
+	return single(factor{base: build(ts), exp: NewNum(1)})[0]
+}
+
+// scale returns each term of ts multiplied by k
+func scale(ts []term, k *big.Rat) []term {
+	scaled := make([]term, len(ts))
+	for i, t := range ts {
+		scaled[i] = term{coeff: new(big.Rat).Mul(t.coeff, k), factors: t.factors}
+	}
+	return scaled
+}
+
+// invert returns 1/t, where its number is a factor if its inverse has not a finite decimal expansion
+func invert(t term) term {
+	inv := term{coeff: big.NewRat(1, 1)}
+
+	if t.coeff.Sign() != 0 {
+		if n, ok := newNum(new(big.Rat).Inv(t.coeff)); ok {
+			inv.coeff = n.value
+		} else {
+			inv.factors = append(inv.factors, factor{base: Num{value: t.coeff}, exp: NewNum(-1)})
+		}
+	} else {
+		inv.factors = append(inv.factors, factor{base: NewNum(0), exp: NewNum(-1)})
+	}
+
+	for _, f := range t.factors {
+		inv.factors = append(inv.factors, factor{base: f.base, exp: negate(f.exp)})
+	}
+	return inv
+}
+
+// multiply returns the product of two terms, where the exponents of the like factors are added
+func multiply(t1, t2 term) term {
+	t := term{coeff: new(big.Rat).Mul(t1.coeff, t2.coeff)}
+
+	index := make(map[string]int)
+	for _, f := range append(append([]factor{}, t1.factors...), t2.factors...) {
+		key := f.base.String()
+
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(t.factors)
+			t.factors = append(t.factors, f)
+			continue
+		}
+
+		t.factors[i].exp = Normalize(add(t.factors[i].exp, f.exp))
+	}
+
+	factors := t.factors[:0]
+	for _, f := range t.factors {
+		if isNum(f.exp, 0) || foldFactor(t.coeff, f) {
+			continue
+		}
+		factors = append(factors, f)
+	}
+	t.factors = factors
+
+	// the numbers of the factors were folded into the number of the term,
+	// which keeps its denominator as a factor if it has not a finite decimal expansion
+	if _, ok := newNum(t.coeff); !ok {
+		den := new(big.Rat).SetInt(t.coeff.Denom())
+		t.factors = append(t.factors, factor{base: Num{value: den}, exp: NewNum(-1)})
+		t.coeff = new(big.Rat).SetInt(t.coeff.Num())
+	}
+
+	sort.SliceStable(t.factors, func(i, j int) bool {
+		return lessFactor(t.factors[i], t.factors[j])
+	})
+	return t
+}
+
+// foldFactor multiplies coeff by the factor and returns true if it is a number raised to an integer,
+// otherwise returns false
+func foldFactor(coeff *big.Rat, f factor) bool {
+	base, ok := f.base.(Num)
+	exp, isNum := f.exp.(Num)
+	if !ok || !isNum || !exp.IsInt() {
+		return false
+	}
+
+	n, ok := foldPow(base.value, new(big.Rat).Abs(exp.value))
+	if !ok || n.Sign() == 0 && exp.Sign() < 0 {
+		return false
+	}
+
+	if exp.Sign() < 0 {
+		coeff.Quo(coeff, n.value)
+		return true
+	}
+
+	coeff.Mul(coeff, n.value)
+	return true
+}
+
+// collect returns the terms with the like ones added, without zeros and sorted
+// by descending degree and then by their factors
+func collect(ts []term) []term {
+	index := make(map[string]int)
+	var collected []term
+
+	for _, t := range ts {
+		key := keyOf(t)
+
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(collected)
+			collected = append(collected, term{coeff: new(big.Rat).Set(t.coeff), factors: t.factors})
+			continue
+		}
+
+		collected[i].coeff.Add(collected[i].coeff, t.coeff)
+	}
+
+	terms := collected[:0]
+	for _, t := range collected {
+		if t.coeff.Sign() != 0 {
+			terms = append(terms, t)
+		}
+	}
+
+	sort.SliceStable(terms, func(i, j int) bool {
+		if c := degreeOf(terms[i]).Cmp(degreeOf(terms[j])); c != 0 {
+			return c > 0
+		}
+		return keyOf(terms[i]) < keyOf(terms[j])
+	})
+	return terms
+}
+
+// build returns the sum of the terms, where a negative term is subtracted
+func build(ts []term) Expr {
+	if len(ts) == 0 {
+		return NewNum(0)
+	}
+
+	var e Expr
+	for _, t := range ts {
+		x := buildTerm(new(big.Rat).Abs(t.coeff), t.factors)
+
+		switch {
+		case e == nil && t.coeff.Sign() < 0:
+			e = negate(x)
+		case e == nil:
+			e = x
+		case t.coeff.Sign() < 0:
+			e = sub(e, x)
+		default:
+			e = add(e, x)
+		}
+	}
+	return e
+}
+
+// buildTerm returns the product of the number and the factors with a positive exponent,
+// divided by the product of the factors with a negative one
+func buildTerm(coeff *big.Rat, factors []factor) Expr {
+	var num, den Expr
+
+	if coeff.Cmp(big.NewRat(1, 1)) != 0 {
+		num = Num{value: coeff}
+	}
+
+	for _, f := range factors {
+		if isNegative(f.exp) {
+			den = product(den, power(f.base, negate(f.exp)))
+			continue
+		}
+		num = product(num, power(f.base, f.exp))
+	}
+
+	if num == nil {
+		num = NewNum(1)
+	}
+
+	if den == nil {
+		return num
+	}
+	return div(num, den)
+}
+
+// product returns x * y, where x may be nil
+func product(x, y Expr) Expr {
+	if x == nil {
+		return y
+	}
+	return mul(x, y)
+}
+
+// power returns base ^ exp, which is the base if exp is 1
+func power(base, exp Expr) Expr {
+	if isNum(exp, 1) {
+		return base
+	}
+	return pow(base, exp)
+}
+
+// keyOf returns the factors of the term as a string, which is the same for like terms,
+// where each factor is wrapped in parentheses, so a factor x*y mod 2 is not the factors x and y mod 2
+func keyOf(t term) string {
+	keys := make([]string, len(t.factors))
+	for i, f := range t.factors {
+		keys[i] = "(" + power(f.base, f.exp).String() + ")"
+	}
+	return strings.Join(keys, "*")
+}
+
+// degreeOf returns the sum of the numeric exponents of the term
+func degreeOf(t term) *big.Rat {
+	degree := new(big.Rat)
+	for _, f := range t.factors {
+		if n, ok := f.exp.(Num); ok {
+			degree.Add(degree, n.value)
+		}
+	}
+	return degree
+}
+
+// lessFactor returns true if f1 goes before f2, where numbers go first,
+// then variables, then calls and roots, and then the rest, each sorted by their string
+func lessFactor(f1, f2 factor) bool {
+	r1, r2 := rankOf(f1.base), rankOf(f2.base)
+	if r1 != r2 {
+		return r1 < r2
+	}
+	return f1.base.String() < f2.base.String()
+}
+
+// rankOf returns the place of the kind of node in a product
+func rankOf(e Expr) int {
+	switch e.(type) {
+	case Num:
+		return 0
+	case Var:
+		return 1
+	case Call, Root:
+		return 2
+	}
+	return 3
+}
@@ -0,0 +1,43 @@
+package symbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "x*1 + 0 + (2+3)*x", want: "6*x"},
+		{expr: "y*x + 2*x*y - x^2/x", want: "3*x*y - x"},
+		{expr: "1 + x + x^2", want: "x^2 + x + 1"},
+		{expr: "y + x", want: "x + y"},
+		{expr: "3 - 2*x", want: "-2*x + 3"},
+		{expr: "(x+1)*(x+1)", want: "(x + 1)^2"},
+		{expr: "(x+1)/2", want: "0.5*(x + 1)"},
+		{expr: "x/3", want: "x/3"},
+		{expr: "x/y*y", want: "x"},
+		{expr: "(x*y)^2", want: "x^2*y^2"},
+		{expr: "x^y * x^2", want: "x^(y + 2)"},
+		{expr: "sin(y+x) - sin(x+y)", want: "0"},
+		{expr: "π*x + x*π", want: "2*x*π"},
+		{expr: "x mod 2 + 1", want: "x mod 2 + 1"},
+		{expr: "x^2/(3*6)", want: "x^2/18"},
+		{expr: "x^2/3/6", want: "x^2/18"},
+		{expr: "6*x/3", want: "2*x"},
+		{expr: "√(x - x)", want: "0"},
+		{expr: "y^(x mod 0.5*3.5)", want: "y^(3.5*(x mod 0.5))"},
+		{expr: "x*y mod 2 + x*(y mod 2)", want: "x*(y mod 2) + x*y mod 2"},
+		{expr: "-y - 3*π mod sin(4)", want: "-3*π mod sin(4) - y"},
+	}
+
+	for _, tt := range tests {
+		got := Normalize(parse(t, tt.expr)).String()
+		assert.Equalf(t, tt.want, got, "%s", tt.expr)
+		assert.Equalf(t, got, Normalize(parse(t, got)).String(), "%s is not idempotent", tt.expr)
+		assert.InDeltaf(t, valueOf(t, tt.expr), valueOf(t, got), 1e-9, "%s does not keep its value", tt.expr)
+	}
+}
@@ -0,0 +1,249 @@
+package symbol
+
+import (
+	"math/big"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/doubly"
+)
+
+// parser represents the reading of a list of tokens, where node is the next one to read
+type parser struct {
+	node *doubly.Node
+}
+
+// Parse returns the expression tree of an analysed list of tokens and nil,
+// otherwise returns nil and an error if a token has no symbolic meaning, like a unit
+func Parse(list *doubly.Doubly) (Expr, error) {
+	p := &parser{node: list.Head()}
+
+	e, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.node != nil {
+		return nil, ierr.KindEnd(data.RuneMap[p.kind()])
+	}
+	return e, nil
+}
+
+// !Parser Methods
+
+// sum reads terms separated by + and -
+func (p *parser) sum() (Expr, error) {
+	x, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.is(data.AddToken) || p.is(data.SubToken) {
+		op := p.next().Kind()
+
+		y, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		x = Binary{Op: op, X: x, Y: y}
+	}
+	return x, nil
+}
+
+// term reads uncertainties separated by *, /, % and ·
+func (p *parser) term() (Expr, error) {
+	x, err := p.uncertainty()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.is(data.MulToken) || p.is(data.DivToken) || p.is(data.ModToken) || p.is(data.ProductToken) {
+		op := p.next().Kind()
+
+		y, err := p.uncertainty()
+		if err != nil {
+			return nil, err
+		}
+		x = Binary{Op: op, X: x, Y: y}
+	}
+	return x, nil
+}
+
+// uncertainty reads powers separated by ±, which binds tighter than any operator but ^
+// like the calculator, so 2*9.81 ± 0.02 is 2*(9.81 ± 0.02)
+func (p *parser) uncertainty() (Expr, error) {
+	x, err := p.power()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.is(data.PlusMinusToken) {
+		p.next()
+
+		y, err := p.power()
+		if err != nil {
+			return nil, err
+		}
+		x = Binary{Op: data.PlusMinusToken, X: x, Y: y}
+	}
+	return x, nil
+}
+
+// power reads unary nodes separated by ^ from left to right like the calculator,
+// so 2^3^2 is (2^3)^2
+func (p *parser) power() (Expr, error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.is(data.PowToken) {
+		p.next()
+
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		x = Binary{Op: data.PowToken, X: x, Y: y}
+	}
+	return x, nil
+}
+
+// unary reads a square root or a negation of a unary node, otherwise a primary one
+func (p *parser) unary() (Expr, error) {
+	switch {
+	case p.is(data.RootToken):
+		p.next()
+		x, err := p.unary()
+		return Root{X: x}, err
+
+	case p.is(data.SubToken):
+		p.next()
+		x, err := p.unary()
+		return Neg{X: x}, err
+	}
+	return p.primary()
+}
+
+// primary reads a number, π, a variable, a call, an array or an expression in parentheses,
+// otherwise returns an error if the token has no symbolic meaning
+func (p *parser) primary() (Expr, error) {
+	if p.node == nil {
+		return nil, ierr.KindEnd(data.RuneMap[data.RightToken])
+	}
+
+	token := p.next()
+
+	switch token.Kind() {
+	case data.NumToken:
+		num := token.(data.Number).Value()
+		r, ok := new(big.Rat).SetString(num)
+		if !ok {
+			return nil, ierr.NumberMisspelled(num)
+		}
+		return Num{value: r}, nil
+
+	case data.PiToken:
+		return Pi, nil
+
+	case data.VarToken:
+		return Var(token.(data.Word).Value()), nil
+
+	case data.FuncToken:
+		name := token.(data.Word).Value()
+		if spelled, ok := data.SeriesName(name); ok {
+			name = spelled
+		}
+		return p.call(name)
+
+	case data.LeftToken:
+		x, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		return x, p.expect(data.RightToken)
+
+	case data.ArrayToken:
+		return p.array()
+	}
+
+	return nil, ierr.NotSymbolic(data.RuneMap[token.Kind()])
+}
+
+// array reads the elements of an array between brackets, which the tokenizer
+// writes as parentheses after the token of the array
+func (p *parser) array() (Expr, error) {
+	err := p.expect(data.LeftToken)
+	if err != nil {
+		return nil, err
+	}
+
+	a := Array{}
+	for !p.is(data.RightToken) {
+		elem, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		a.Elems = append(a.Elems, elem)
+
+		if !p.is(data.CommaToken) {
+			break
+		}
+		p.next()
+	}
+
+	return a, p.expect(data.RightToken)
+}
+
+// call reads the arguments of a function between parentheses
+func (p *parser) call(name string) (Expr, error) {
+	err := p.expect(data.LeftToken)
+	if err != nil {
+		return nil, err
+	}
+
+	c := Call{Name: name}
+	for {
+		arg, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		c.Args = append(c.Args, arg)
+
+		if !p.is(data.CommaToken) {
+			break
+		}
+		p.next()
+	}
+
+	return c, p.expect(data.RightToken)
+}
+
+// expect reads a token of the given kind, otherwise returns an error
+func (p *parser) expect(kind data.TokenKind) error {
+	if !p.is(kind) {
+		return ierr.KindNotTogether(data.RuneMap[kind], data.RuneMap[p.kind()])
+	}
+	p.next()
+	return nil
+}
+
+// is returns true if the next token is of the given kind, otherwise returns false
+func (p *parser) is(kind data.TokenKind) bool {
+	return p.node != nil && p.node.Token().Kind() == kind
+}
+
+// kind returns the kind of the next token, which is a RightToken at the end
+func (p *parser) kind() data.TokenKind {
+	if p.node == nil {
+		return data.RightToken
+	}
+	return p.node.Token().Kind()
+}
+
+// next returns the next token and moves to the following one
+func (p *parser) next() data.Token {
+	token := p.node.Token()
+	p.node = p.node.Next()
+	return token
+}
@@ -0,0 +1,118 @@
+package symbol
+
+import (
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/analyse"
+	"github.com/brianlewyn/go-calculator/internal/data"
+	"github.com/brianlewyn/go-calculator/internal/math"
+	"github.com/brianlewyn/go-calculator/internal/tokenize"
+	"github.com/stretchr/testify/assert"
+)
+
+// parse returns the expression tree of an expression
+func parse(t *testing.T, expression string) Expr {
+	list, err := tokenize.Tokenizer(expression)
+	assert.Nil(t, err, "error != nil")
+
+	err = analyse.Analyser(list)
+	assert.Nil(t, err, "error != nil")
+
+	e, err := Parse(list)
+	assert.Nil(t, err, "error != nil")
+	return e
+}
+
+// env resolves the variables x and y of the tests
+type env struct{}
+
+func (env) Var(name string) (data.Token, bool) {
+	switch name {
+	case "x":
+		return data.NewDecimalToken(1.3), true
+	case "y":
+		return data.NewDecimalToken(2.7), true
+	}
+	return nil, false
+}
+
+func (env) Func(string) (math.Func, bool) { return nil, false }
+
+// valueOf returns the result of an expression, where x is 1.3 and y is 2.7
+func valueOf(t *testing.T, expression string) float64 {
+	res, err := evaluate(expression)
+	assert.Nilf(t, err, "%s: error != nil", expression)
+	return res
+}
+
+// evaluate returns the result of an expression and nil, where x is 1.3 and y is 2.7,
+// otherwise returns an error
+func evaluate(expression string) (float64, error) {
+	list, err := tokenize.Tokenizer(expression)
+	if err != nil {
+		return 0, err
+	}
+
+	err = analyse.Analyser(list)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Math(list, env{}, math.Options{})
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "x^2 * sin(x)", want: "x^2*sin(x)"},
+		{expr: "1 + 2 * 3", want: "1 + 2*3"},
+		{expr: "(1 + 2) * 3", want: "(1 + 2)*3"},
+		{expr: "1 - (2 - 3)", want: "1 - (2 - 3)"},
+		{expr: "2^3^2", want: "2^3^2"},
+		{expr: "2^(3^2)", want: "2^(3^2)"},
+		{expr: "√x^2", want: "√x^2"},
+		{expr: "√(x + 1)", want: "√(x + 1)"},
+		{expr: "-x^2", want: "0 - x^2"},
+		{expr: "x * -2", want: "x*(0 - 2)"},
+		{expr: "7 % 2.50", want: "7 mod 2.5"},
+		{expr: "2*π + max(y, x)", want: "2*π + max(y, x)"},
+		{expr: "2 * 9.81 ± 0.02", want: "2*9.81 ± 0.02"},
+		{expr: "(9.81 ± 0.02)^2", want: "(9.81 ± 0.02)^2"},
+		{expr: "[1,2]·[x,4]", want: "[1, 2]·[x, 4]"},
+		{expr: "linsolve([[2,1],[1,3]], [3,5])", want: "linsolve([[2, 1], [1, 3]], [3, 5])"},
+	}
+
+	for _, tt := range tests {
+		assert.Equalf(t, tt.want, parse(t, tt.expr).String(), "%s", tt.expr)
+	}
+
+	list, err := tokenize.TokenizerWith("3 km", tokenize.Options{Units: true})
+	assert.Nil(t, err, "error != nil")
+
+	_, err = Parse(list)
+	assert.True(t, ierr.As(err, ierr.CtxNotSymbolic), "error != CtxNotSymbolic")
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "2*(x mod 3)", want: "2*(x mod 3)"},
+		{expr: "2*(x / 3)", want: "2*(x/3)"},
+		{expr: "x mod (3*y)", want: "x mod (3*y)"},
+		{expr: "2*(x*y)", want: "2*x*y"},
+		{expr: "x + (y + 1)", want: "x + y + 1"},
+		{expr: "x - (y + 1)", want: "x - (y + 1)"},
+		{expr: "y^(x mod 0.5*3.5)", want: "y^(x mod 0.5*3.5)"},
+	}
+
+	for _, tt := range tests {
+		e := parse(t, tt.expr)
+		assert.Equalf(t, tt.want, e.String(), "%s", tt.expr)
+		assert.InDeltaf(t, valueOf(t, tt.expr), valueOf(t, e.String()), 1e-12, "%s", tt.expr)
+	}
+}
@@ -0,0 +1,272 @@
+package symbol
+
+import (
+	"strings"
+
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// latexFuncs are the functions that LaTeX writes with their own command
+var latexFuncs = map[string]bool{
+	"sin": true, "cos": true, "tan": true, "arcsin": true, "arccos": true, "arctan": true,
+	"sinh": true, "cosh": true, "tanh": true, "exp": true, "ln": true, "log": true,
+	"max": true, "min": true, "det": true, "gcd": true,
+}
+
+// LaTeX returns the expression tree written in LaTeX, where a division is a fraction,
+// a power is a superscript and the parentheses are the ones that keep its meaning
+//
+//	(1 + x)^2/√π => \frac{\left(1 + x\right)^{2}}{\sqrt{\pi}}
+func LaTeX(e Expr) string {
+	var b strings.Builder
+	writeLaTeX(&b, canonical(e))
+	return b.String()
+}
+
+// MathML returns the expression tree written in presentation MathML, where a division is a fraction,
+// a power is a superscript and the parentheses are the ones that keep its meaning
+//
+//	x^2 => <math xmlns="http://www.w3.org/1998/Math/MathML"><msup><mi>x</mi><mn>2</mn></msup></math>
+func MathML(e Expr) string {
+	var b strings.Builder
+	b.WriteString(`<math xmlns="http://www.w3.org/1998/Math/MathML">`)
+	writeMathML(&b, canonical(e))
+	b.WriteString("</math>")
+	return b.String()
+}
+
+// !Tool Functions
+
+// writeLaTeX writes the node in LaTeX
+func writeLaTeX(b *strings.Builder, e Expr) {
+	switch e := e.(type) {
+	case Num:
+		b.WriteString(e.String())
+
+	case Var:
+		if e == Pi {
+			b.WriteString(`\pi`)
+			return
+		}
+		b.WriteString(latexName(string(e)))
+
+	case Neg:
+		b.WriteRune(data.Sub)
+		writeLaTeXOperand(b, e.X, displayPrecedence(e.X) <= precAdd)
+
+	case Root:
+		b.WriteString(`\sqrt{`)
+		writeLaTeX(b, e.X)
+		b.WriteString("}")
+
+	case Call:
+		if e.Name == "abs" && len(e.Args) == 1 {
+			b.WriteString(`\left|`)
+			writeLaTeX(b, e.Args[0])
+			b.WriteString(`\right|`)
+			return
+		}
+
+		if latexFuncs[e.Name] {
+			b.WriteString(`\` + e.Name)
+		} else {
+			b.WriteString(`\operatorname{` + strings.ReplaceAll(e.Name, string(data.Under), `\_`) + "}")
+		}
+
+		b.WriteString(`\left(`)
+		for i, arg := range e.Args {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeLaTeX(b, arg)
+		}
+		b.WriteString(`\right)`)
+
+	case Array:
+		b.WriteString(`\left[`)
+		for i, elem := range e.Elems {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeLaTeX(b, elem)
+		}
+		b.WriteString(`\right]`)
+
+	case Binary:
+		switch e.Op {
+		case data.DivToken:
+			b.WriteString(`\frac{`)
+			writeLaTeX(b, e.X)
+			b.WriteString("}{")
+			writeLaTeX(b, e.Y)
+			b.WriteString("}")
+
+		case data.PowToken:
+			writeLaTeXOperand(b, e.X, !isBase(e.X))
+			b.WriteString("^{")
+			writeLaTeX(b, e.Y)
+			b.WriteString("}")
+
+		default:
+			x, y := needsParentheses(e)
+			writeLaTeXOperand(b, e.X, x)
+			b.WriteString(" " + latexOperator(e.Op) + " ")
+			writeLaTeXOperand(b, e.Y, y)
+		}
+	}
+}
+
+// writeLaTeXOperand writes the node in LaTeX, wrapped in parentheses if 'wrap' is true
+func writeLaTeXOperand(b *strings.Builder, e Expr, wrap bool) {
+	if !wrap {
+		writeLaTeX(b, e)
+		return
+	}
+
+	b.WriteString(`\left(`)
+	writeLaTeX(b, e)
+	b.WriteString(`\right)`)
+}
+
+// latexOperator returns the LaTeX of the operator of the given kind
+func latexOperator(op data.TokenKind) string {
+	switch op {
+	case data.MulToken, data.ProductToken:
+		return `\cdot`
+	case data.ModToken:
+		return `\bmod`
+	case data.PlusMinusToken:
+		return `\pm`
+	}
+	return string(data.RuneMap[op])
+}
+
+// latexName returns a name in LaTeX, where a name of more than one letter is upright
+func latexName(name string) string {
+	name = strings.ReplaceAll(name, string(data.Under), `\_`)
+	if len(name) == 1 {
+		return name
+	}
+	return `\mathrm{` + name + "}"
+}
+
+// writeMathML writes the node in presentation MathML
+func writeMathML(b *strings.Builder, e Expr) {
+	switch e := e.(type) {
+	case Num:
+		b.WriteString("<mn>" + e.String() + "</mn>")
+
+	case Var:
+		b.WriteString("<mi>" + string(e) + "</mi>")
+
+	case Neg:
+		b.WriteString("<mrow><mo>−</mo>")
+		writeMathMLOperand(b, e.X, displayPrecedence(e.X) <= precAdd)
+		b.WriteString("</mrow>")
+
+	case Root:
+		b.WriteString("<msqrt>")
+		writeMathML(b, e.X)
+		b.WriteString("</msqrt>")
+
+	case Call:
+		b.WriteString("<mrow><mi>" + e.Name + "</mi><mo>&#x2061;</mo><mrow><mo>(</mo>")
+		for i, arg := range e.Args {
+			if i > 0 {
+				b.WriteString("<mo>,</mo>")
+			}
+			writeMathML(b, arg)
+		}
+		b.WriteString("<mo>)</mo></mrow></mrow>")
+
+	case Array:
+		b.WriteString("<mrow><mo>[</mo>")
+		for i, elem := range e.Elems {
+			if i > 0 {
+				b.WriteString("<mo>,</mo>")
+			}
+			writeMathML(b, elem)
+		}
+		b.WriteString("<mo>]</mo></mrow>")
+
+	case Binary:
+		switch e.Op {
+		case data.DivToken:
+			b.WriteString("<mfrac>")
+			writeMathML(b, e.X)
+			writeMathML(b, e.Y)
+			b.WriteString("</mfrac>")
+
+		case data.PowToken:
+			b.WriteString("<msup>")
+			writeMathMLOperand(b, e.X, !isBase(e.X))
+			writeMathML(b, e.Y)
+			b.WriteString("</msup>")
+
+		default:
+			x, y := needsParentheses(e)
+			b.WriteString("<mrow>")
+			writeMathMLOperand(b, e.X, x)
+			b.WriteString("<mo>" + mathMLOperator(e.Op) + "</mo>")
+			writeMathMLOperand(b, e.Y, y)
+			b.WriteString("</mrow>")
+		}
+	}
+}
+
+// writeMathMLOperand writes the node in presentation MathML, wrapped in parentheses if 'wrap' is true
+func writeMathMLOperand(b *strings.Builder, e Expr, wrap bool) {
+	if !wrap {
+		writeMathML(b, e)
+		return
+	}
+
+	b.WriteString("<mrow><mo>(</mo>")
+	writeMathML(b, e)
+	b.WriteString("<mo>)</mo></mrow>")
+}
+
+// mathMLOperator returns the MathML of the operator of the given kind
+func mathMLOperator(op data.TokenKind) string {
+	switch op {
+	case data.SubToken:
+		return "−"
+	case data.MulToken:
+		return "⋅"
+	case data.ModToken:
+		return "mod"
+	}
+	return string(data.RuneMap[op])
+}
+
+// needsParentheses returns if each operand of the operation, which is not a division
+// or a power, is wrapped in parentheses to keep its meaning, where the operand on the right
+// is wrapped if it has the same precedence, since the operations are done from left to right
+func needsParentheses(b Binary) (x, y bool) {
+	prec := precedenceOf(b.Op)
+
+	x = displayPrecedence(b.X) < prec || isNegative(b.X) && prec > precAdd
+	y = displayPrecedence(b.Y) <= prec || isNegative(b.Y)
+	return x, y
+}
+
+// displayPrecedence returns the precedence of the node once it is displayed,
+// where a fraction groups its operands like a number
+func displayPrecedence(e Expr) int {
+	if b, ok := e.(Binary); ok && b.Op == data.DivToken {
+		return precAtom
+	}
+	return precedence(e)
+}
+
+// isBase returns true if the node is written as the base of a superscript without parentheses,
+// which is the case of a variable and a number that is not negative, otherwise returns false
+func isBase(e Expr) bool {
+	switch e := e.(type) {
+	case Var:
+		return true
+	case Num:
+		return e.Sign() >= 0
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package symbol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLaTeX(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "1 + 2*3", want: `1 + 2 \cdot 3`},
+		{expr: "(1 + 2)*3", want: `\left(1 + 2\right) \cdot 3`},
+		{expr: "1 - (2 - 3)", want: `1 - \left(2 - 3\right)`},
+		{expr: "(1 + x)^2/√π", want: `\frac{\left(1 + x\right)^{2}}{\sqrt{\pi}}`},
+		{expr: "x*(y/z)", want: `x \cdot \frac{y}{z}`},
+		{expr: "2^3^2", want: `\left(2^{3}\right)^{2}`},
+		{expr: "2^(x + 1)", want: `2^{x + 1}`},
+		{expr: "√x^2", want: `\left(\sqrt{x}\right)^{2}`},
+		{expr: "-x^2", want: `-x^{2}`},
+		{expr: "(-x)^2", want: `\left(-x\right)^{2}`},
+		{expr: "x * -2", want: `x \cdot \left(-2\right)`},
+		{expr: "-(x + 1)", want: `-\left(x + 1\right)`},
+		{expr: "7 mod 2", want: `7 \bmod 2`},
+		{expr: "sin(x)^2 + abs(y)", want: `\left(\sin\left(x\right)\right)^{2} + \left|y\right|`},
+		{expr: "sqrt(2) * gamma(rate_1)", want: `\sqrt{2} \cdot \operatorname{gamma}\left(\mathrm{rate\_1}\right)`},
+		{expr: "(9.81 ± 0.02)^2", want: `\left(9.81 \pm 0.02\right)^{2}`},
+		{expr: "[1, x]·[2, 3]", want: `\left[1, x\right] \cdot \left[2, 3\right]`},
+	}
+
+	for _, tt := range tests {
+		assert.Equalf(t, tt.want, LaTeX(parse(t, tt.expr)), "%s", tt.expr)
+	}
+}
+
+func TestMathML(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "x^2", want: "<msup><mi>x</mi><mn>2</mn></msup>"},
+		{expr: "(1 + 2)*3", want: "<mrow><mrow><mo>(</mo><mrow><mn>1</mn><mo>+</mo><mn>2</mn></mrow><mo>)</mo></mrow><mo>⋅</mo><mn>3</mn></mrow>"},
+		{expr: "1/√π", want: "<mfrac><mn>1</mn><msqrt><mi>π</mi></msqrt></mfrac>"},
+		{expr: "-x - 1", want: "<mrow><mrow><mo>−</mo><mi>x</mi></mrow><mo>−</mo><mn>1</mn></mrow>"},
+		{expr: "max(a, 2)", want: "<mrow><mi>max</mi><mo>&#x2061;</mo><mrow><mo>(</mo><mi>a</mi><mo>,</mo><mn>2</mn><mo>)</mo></mrow></mrow>"},
+		{expr: "a ± 2", want: "<mrow><mi>a</mi><mo>±</mo><mn>2</mn></mrow>"},
+		{expr: "[1, x]", want: "<mrow><mo>[</mo><mn>1</mn><mo>,</mo><mi>x</mi><mo>]</mo></mrow>"},
+	}
+
+	for _, tt := range tests {
+		want := `<math xmlns="http://www.w3.org/1998/Math/MathML">` + tt.want + "</math>"
+		assert.Equalf(t, want, MathML(parse(t, tt.expr)), "%s", tt.expr)
+	}
+}
@@ -0,0 +1,342 @@
+package symbol
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// sexprOperators are the operators that an S-expression can start with,
+// which are done from left to right when there are more than two operands
+var sexprOperators = map[string]data.TokenKind{
+	"+": data.AddToken, "-": data.SubToken,
+	"*": data.MulToken, string(data.Times): data.MulToken,
+	"/": data.DivToken, string(data.Obelus): data.DivToken,
+	"mod": data.ModToken, string(data.Mod): data.ModToken,
+	string(data.Product): data.ProductToken, string(data.PlusMinus): data.PlusMinusToken,
+	"^": data.PowToken,
+}
+
+// reader represents the reading of an S-expression, where i is the index of the next rune to read
+type reader struct {
+	runes []rune
+	i     int
+}
+
+// SExpr returns the expression tree written as an S-expression, where each operation is a list
+// that starts with its operator, a negation is a subtraction of one operand, a square root starts with √,
+// a call starts with the name of the function and an array is its elements between brackets
+//
+//	1 + 2*√x - π => (- (+ 1 (* 2 (√ x))) π)
+//	[1, 2]·[x, 4] => (· [1 2] [x 4])
+func SExpr(e Expr) string {
+	var b strings.Builder
+	writeSExpr(&b, canonical(e))
+	return b.String()
+}
+
+// ReadSExpr returns the expression tree of an S-expression and nil, where an operator
+// with more than two operands is done from left to right, a negative number is a negation
+// and the forms between brackets are an array, otherwise returns nil and an error
+//
+//	(+ 1 (* 2 3)) => 1 + 2*3
+//	(- 10 2 3) => (10 - 2) - 3
+//	(· [1 2] [3 4]) => [1, 2]·[3, 4]
+func ReadSExpr(sexpr string) (Expr, error) {
+	r := &reader{runes: []rune(sexpr)}
+
+	if !r.skip() {
+		return nil, ierr.EmptyField
+	}
+
+	e, err := r.form()
+	if err != nil {
+		return nil, err
+	}
+
+	if r.skip() && (r.runes[r.i] == data.Right || r.runes[r.i] == data.RightBracket) {
+		return nil, ierr.IncompleteRight
+	}
+
+	if n := r.leftover(); n > 0 {
+		return nil, ierr.LeftoverOperands(n)
+	}
+	return e, nil
+}
+
+// !Reader Methods
+
+// form reads a list, an array or an atom
+func (r *reader) form() (Expr, error) {
+	if !r.skip() {
+		return nil, ierr.IncompleteLeft
+	}
+
+	switch r.runes[r.i] {
+	case data.Left:
+		return r.list()
+	case data.LeftBracket:
+		return r.array()
+	case data.Right, data.RightBracket:
+		return nil, ierr.IncompleteRight
+	}
+
+	i, text := r.atom()
+	return atomOf(text, i)
+}
+
+// list reads an operator or a function followed by its operands up to the right parenthesis
+func (r *reader) list() (Expr, error) {
+	r.i++
+
+	if !r.skip() {
+		return nil, ierr.IncompleteLeft
+	}
+
+	switch k := r.runes[r.i]; k {
+	case data.Left, data.Right, data.LeftBracket, data.RightBracket:
+		return nil, ierr.KindNotTogether(data.Left, k)
+	}
+
+	_, head := r.atom()
+
+	var args []Expr
+	for {
+		if !r.skip() {
+			return nil, ierr.IncompleteLeft
+		}
+
+		if r.runes[r.i] == data.Right {
+			r.i++
+			break
+		}
+
+		arg, err := r.form()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+
+	return listOf(head, args)
+}
+
+// array reads the forms up to the right bracket
+func (r *reader) array() (Expr, error) {
+	r.i++
+
+	a := Array{}
+	for {
+		if !r.skip() {
+			return nil, ierr.IncompleteLeft
+		}
+
+		switch r.runes[r.i] {
+		case data.RightBracket:
+			r.i++
+			return a, nil
+		case data.Right:
+			return nil, ierr.KindNotTogether(data.LeftBracket, data.Right)
+		}
+
+		elem, err := r.form()
+		if err != nil {
+			return nil, err
+		}
+		a.Elems = append(a.Elems, elem)
+	}
+}
+
+// atom reads the text up to a space, a parenthesis or a bracket and returns it with the index where it starts
+func (r *reader) atom() (int, string) {
+	start := r.i
+	for r.i < len(r.runes) && !isDelimiter(r.runes[r.i]) {
+		r.i++
+	}
+	return start, string(r.runes[start:r.i])
+}
+
+// leftover returns the number of forms after the one that was read
+func (r *reader) leftover() int {
+	n := 0
+	for r.skip() {
+		if _, err := r.form(); err != nil && r.i < len(r.runes) {
+			r.i++
+		}
+		n++
+	}
+	return n
+}
+
+// skip moves past the spaces and returns true if there is a rune to read, otherwise returns false
+func (r *reader) skip() bool {
+	for r.i < len(r.runes) && unicode.IsSpace(r.runes[r.i]) {
+		r.i++
+	}
+	return r.i < len(r.runes)
+}
+
+// !Tool Functions
+
+// writeSExpr writes the node as an S-expression
+func writeSExpr(b *strings.Builder, e Expr) {
+	switch e := e.(type) {
+	case Num:
+		if e.Sign() < 0 {
+			writeSExpr(b, Neg{X: Num{value: new(big.Rat).Neg(e.value)}})
+			return
+		}
+		b.WriteString(e.String())
+
+	case Var:
+		b.WriteString(string(e))
+
+	case Neg:
+		writeList(b, string(data.Sub), e.X)
+
+	case Binary:
+		writeList(b, sexprOperator(e.Op), e.X, e.Y)
+
+	case Root:
+		writeList(b, string(data.Root), e.X)
+
+	case Call:
+		writeList(b, e.Name, e.Args...)
+
+	case Array:
+		b.WriteRune(data.LeftBracket)
+		for i, elem := range e.Elems {
+			if i > 0 {
+				b.WriteRune(data.Gap)
+			}
+			writeSExpr(b, elem)
+		}
+		b.WriteRune(data.RightBracket)
+	}
+}
+
+// writeList writes a list of the head followed by the nodes separated by spaces
+func writeList(b *strings.Builder, head string, nodes ...Expr) {
+	b.WriteRune(data.Left)
+	b.WriteString(head)
+	for _, node := range nodes {
+		b.WriteRune(data.Gap)
+		writeSExpr(b, node)
+	}
+	b.WriteRune(data.Right)
+}
+
+// sexprOperator returns the head of the S-expression of the operator of the given kind
+func sexprOperator(op data.TokenKind) string {
+	if op == data.ModToken {
+		return "mod"
+	}
+	return string(data.RuneMap[op])
+}
+
+// atomOf returns the node of an atom, which is a number, π or a variable,
+// where i is the index where it starts, otherwise returns an error
+func atomOf(text string, i int) (Expr, error) {
+	runes := []rune(text)
+
+	switch first := runes[0]; {
+	case text == string(data.Pi):
+		return Pi, nil
+
+	case data.IsDecimal(first):
+		return numberOf(runes, i)
+
+	case first == data.Sub && len(runes) > 1 && data.IsDecimal(runes[1]):
+		x, err := numberOf(runes[1:], i+1)
+		if err != nil {
+			return nil, err
+		}
+		return Neg{X: x}, nil
+
+	case data.IsLetter(first):
+		for j, r := range runes {
+			if !data.IsWord(r) {
+				return nil, ierr.RuneUnknown(r, i+j)
+			}
+		}
+
+		if isReserved(text) {
+			return nil, ierr.NameReserved(text)
+		}
+		return Var(text), nil
+
+	case isReserved(text):
+		return nil, ierr.NameReserved(text)
+	}
+
+	return nil, ierr.RuneUnknown(runes[0], i)
+}
+
+// numberOf returns the node of a number, where i is the index where it starts, otherwise returns an error
+func numberOf(runes []rune, i int) (Expr, error) {
+	for j, r := range runes {
+		if !data.IsDecimal(r) {
+			return nil, ierr.RuneUnknown(r, i+j)
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(string(runes))
+	if !ok {
+		return nil, ierr.NumberMisspelled(string(runes))
+	}
+	return Num{value: r}, nil
+}
+
+// listOf returns the node of a list that starts with the head and has the given operands,
+// otherwise returns an error if the head is unknown or has a wrong number of operands
+func listOf(head string, args []Expr) (Expr, error) {
+	if op, ok := sexprOperators[head]; ok {
+		if op == data.SubToken && len(args) == 1 {
+			return Neg{X: args[0]}, nil
+		}
+
+		if len(args) < 2 {
+			return nil, ierr.ArgumentCount(head, len(args))
+		}
+
+		x := args[0]
+		for _, y := range args[1:] {
+			x = Binary{Op: op, X: x, Y: y}
+		}
+		return x, nil
+	}
+
+	if head == string(data.Root) {
+		if len(args) != 1 {
+			return nil, ierr.ArgumentCount(head, len(args))
+		}
+		return Root{X: args[0]}, nil
+	}
+
+	for j, r := range head {
+		if !data.IsWord(r) || j == 0 && !data.IsLetter(r) {
+			return nil, ierr.NameMisspelled(head)
+		}
+	}
+
+	if arity, ok := data.BuiltinMap[head]; ok && !arity.Accepts(len(args)) || len(args) == 0 {
+		return nil, ierr.ArgumentCount(head, len(args))
+	}
+	return Call{Name: head, Args: args}, nil
+}
+
+// isReserved returns true if the name is an operator, a keyword or a builtin function,
+// which can not be the name of a variable, otherwise returns false
+func isReserved(name string) bool {
+	_, isOperator := sexprOperators[name]
+	return isOperator || name == string(data.Root) || data.IsKeyword(name) || data.IsBuiltin(name)
+}
+
+// isDelimiter returns true if the rune ends an atom, which is a space, a parenthesis or a bracket
+func isDelimiter(r rune) bool {
+	return unicode.IsSpace(r) || r == data.Left || r == data.Right ||
+		r == data.LeftBracket || r == data.RightBracket
+}
@@ -0,0 +1,89 @@
+package symbol
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/brianlewyn/go-calculator/ierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSExpr(t *testing.T) {
+	tests := []struct {
+		expr  string
+		sexpr string
+	}{
+		{expr: "1+2*3", sexpr: "(+ 1 (* 2 3))"},
+		{expr: "(1+2)*3", sexpr: "(* (+ 1 2) 3)"},
+		{expr: "a - (b - c)", sexpr: "(- a (- b c))"},
+		{expr: "2^3^2", sexpr: "(^ (^ 2 3) 2)"},
+		{expr: "7 % 2.50 / x", sexpr: "(/ (mod 7 2.5) x)"},
+		{expr: "-√π", sexpr: "(- (√ π))"},
+		{expr: "sqrt(x+1)", sexpr: "(√ (+ x 1))"},
+		{expr: "x * -2", sexpr: "(* x (- 2))"},
+		{expr: "-(-x)", sexpr: "(- (- x))"},
+		{expr: "max(y, 1, 2) × sin(x)", sexpr: "(* (max y 1 2) (sin x))"},
+		{expr: "2 * 9.81 ± 0.02", sexpr: "(* 2 (± 9.81 0.02))"},
+		{expr: "[1,2]·[x,[3,4]]", sexpr: "(· [1 2] [x [3 4]])"},
+	}
+
+	for _, tt := range tests {
+		e := parse(t, tt.expr)
+		assert.Equalf(t, tt.sexpr, SExpr(e), "%s", tt.expr)
+
+		read, err := ReadSExpr(tt.sexpr)
+		assert.Nilf(t, err, "%s: error != nil", tt.sexpr)
+		assert.Equalf(t, tt.sexpr, SExpr(read), "%s", tt.sexpr)
+		assert.Equalf(t, tt.sexpr, SExpr(parse(t, Format(read, Style{}))), "%s", tt.sexpr)
+	}
+
+	assert.Equal(t, "(- 1.5)", SExpr(Num{value: big.NewRat(-3, 2)}))
+}
+
+func TestReadSExpr(t *testing.T) {
+	tests := []struct {
+		sexpr string
+		want  string
+		as    ierr.KindOf
+		err   error
+	}{
+		{sexpr: " ( -  10 2 3 ) ", want: "(- (- 10 2) 3)"},
+		{sexpr: "(+ 1 2 3 4)", want: "(+ (+ (+ 1 2) 3) 4)"},
+		{sexpr: "(÷ (× 2 -3) π)", want: "(/ (* 2 (- 3)) π)"},
+		{sexpr: "x_1", want: "x_1"},
+		{sexpr: "(% 10 3 2)", want: "(mod (mod 10 3) 2)"},
+		{sexpr: "(± 9.81 0.02)", want: "(± 9.81 0.02)"},
+		{sexpr: "[ 1 (+ x 2)]", want: "[1 (+ x 2)]"},
+		{sexpr: "", err: ierr.EmptyField},
+		{sexpr: "(+ 1", err: ierr.IncompleteLeft},
+		{sexpr: "(+ 1 2))", err: ierr.IncompleteRight},
+		{sexpr: "[1 2", err: ierr.IncompleteLeft},
+		{sexpr: "[1 2]]", err: ierr.IncompleteRight},
+		{sexpr: "[1 2)", as: ierr.CtxKindNotTogether},
+		{sexpr: "([ 1 2)", as: ierr.CtxKindNotTogether},
+		{sexpr: "1 (+ 2 3)", as: ierr.CtxLeftoverOperands},
+		{sexpr: "()", as: ierr.CtxKindNotTogether},
+		{sexpr: "((+ 1 2) 3)", as: ierr.CtxKindNotTogether},
+		{sexpr: "(1 2)", as: ierr.CtxNameMisspelled},
+		{sexpr: "(* 2)", as: ierr.CtxArgumentCount},
+		{sexpr: "(√ 1 2)", as: ierr.CtxArgumentCount},
+		{sexpr: "(max)", as: ierr.CtxArgumentCount},
+		{sexpr: "(+ mod 2)", as: ierr.CtxNameReserved},
+		{sexpr: "(+ 1.2.3 2)", as: ierr.CtxNumberMisspelled},
+		{sexpr: "(+ 1 $)", as: ierr.CtxRuneUnknown},
+	}
+
+	for _, tt := range tests {
+		e, err := ReadSExpr(tt.sexpr)
+
+		switch {
+		case tt.err != nil:
+			assert.ErrorIsf(t, err, tt.err, "%s", tt.sexpr)
+		case tt.as != "":
+			assert.Truef(t, ierr.As(err, tt.as), "%s: error != %v", tt.sexpr, tt.as)
+		default:
+			assert.Nilf(t, err, "%s: error != nil", tt.sexpr)
+			assert.Equalf(t, tt.want, SExpr(e), "%s", tt.sexpr)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package symbol
+
+import (
+	"math/big"
+
+	"github.com/brianlewyn/go-calculator/internal/data"
+)
+
+// maxFoldBits is the greatest size in bits of a power of numbers that is folded into one number
+const maxFoldBits = 4096
+
+// maxPasses is the limit of times that an expression is simplified again until its form does not change
+const maxPasses = 16
+
+// Simplify returns e with its operations of numbers folded into one number
+// when the result is exact, and the identities and the zeros eliminated,
+// which is done again until nothing changes, so simplifying the result returns it unchanged
+//
+//	x*1 + 0 = x
+//	(2+3)*x = 5*x
+//	0 - x^1 = -x
+func Simplify(e Expr) Expr {
+	return fixed(e, simplify)
+}
+
+// !Tool Functions
+
+// fixed returns the result of applying pass to e again until its form does not change
+func fixed(e Expr, pass func(Expr) Expr) Expr {
+	s := pass(e)
+	for i := 1; i < maxPasses; i++ {
+		next := pass(s)
+		if next.String() == s.String() {
+			break
+		}
+		s = next
+	}
+	return s
+}
+
+// simplify returns e simplified once
+func simplify(e Expr) Expr {
+	switch e := e.(type) {
+	case Neg:
+		return negate(simplify(e.X))
+
+	case Root:
+		return root(simplify(e.X))
+
+	case Call:
+		args := make([]Expr, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = simplify(arg)
+		}
+		return Call{Name: e.Name, Args: args}
+
+	case Array:
+		elems := make([]Expr, len(e.Elems))
+		for i, elem := range e.Elems {
+			elems[i] = simplify(elem)
+		}
+		return Array{Elems: elems}
+
+	case Binary:
+		return simplifyBinary(e.Op, simplify(e.X), simplify(e.Y))
+	}
+	return e
+}
+
+// simplifyBinary returns the operation of the simplified nodes x and y simplified
+func simplifyBinary(op data.TokenKind, x, y Expr) Expr {
+	nx, okX := x.(Num)
+	ny, okY := y.(Num)
+
+	if okX && okY {
+		if n, ok := fold(op, nx.value, ny.value); ok {
+			return n
+		}
+	}
+
+	switch op {
+	case data.AddToken:
+		return simplifyAdd(x, y)
+	case data.SubToken:
+		return simplifySub(x, y)
+	case data.MulToken:
+		return simplifyMul(x, y)
+	case data.DivToken:
+		return simplifyDiv(x, y)
+	case data.PowToken:
+		return simplifyPow(x, y)
+	}
+	return Binary{Op: op, X: x, Y: y}
+}
+
+// simplifyAdd returns x + y without zeros, where adding a negative node is a subtraction
+func simplifyAdd(x, y Expr) Expr {
+	switch {
+	case isNum(x, 0):
+		return y
+	case isNum(y, 0):
+		return x
+	case isNegative(y):
+		return simplifySub(x, negate(y))
+	case isNegative(x):
+		return simplifySub(y, negate(x))
+	}
+	return add(x, y)
+}
+
+// simplifySub returns x - y without zeros, where subtracting a negative node is an addition
+func simplifySub(x, y Expr) Expr {
+	switch {
+	case isNum(y, 0):
+		return x
+	case isNum(x, 0):
+		return negate(y)
+	case isNegative(y):
+		return simplifyAdd(x, negate(y))
+	}
+	return sub(x, y)
+}
+
+// simplifyMul returns x * y without ones and with the number first, where a zero is the product,
+// a negation is taken out and multiplying by 1/a is dividing by a
+func simplifyMul(x, y Expr) Expr {
+	switch {
+	case isNum(x, 0), isNum(y, 0):
+		return NewNum(0)
+	case isNum(x, 1):
+		return y
+	case isNum(y, 1):
+		return x
+	case isNum(x, -1):
+		return negate(y)
+	case isNum(y, -1):
+		return negate(x)
+	}
+
+	if nx, ok := x.(Neg); ok {
+		return negate(simplifyMul(nx.X, y))
+	}
+
+	if ny, ok := y.(Neg); ok {
+		return negate(simplifyMul(x, ny.X))
+	}
+
+	// (1/a)*b = b/a
+	if dx, ok := x.(Binary); ok && dx.Op == data.DivToken && isNum(dx.X, 1) {
+		return simplifyDiv(y, dx.Y)
+	}
+
+	if dy, ok := y.(Binary); ok && dy.Op == data.DivToken && isNum(dy.X, 1) {
+		return simplifyDiv(x, dy.Y)
+	}
+
+	if _, ok := y.(Num); ok {
+		x, y = y, x
+	}
+
+	// 2*(3*x) = 6*x, x*(2*y) = 2*(x*y) and (2*x)*y = 2*(x*y)
+	if bx, ok := x.(Binary); ok && bx.Op == data.MulToken {
+		if nx, ok := bx.X.(Num); ok {
+			return simplifyMul(nx, simplifyMul(bx.Y, y))
+		}
+	}
+
+	if by, ok := y.(Binary); ok && by.Op == data.MulToken {
+		if ny, ok := by.X.(Num); ok {
+			if nx, ok := x.(Num); ok {
+				return simplifyMul(Num{value: new(big.Rat).Mul(nx.value, ny.value)}, by.Y)
+			}
+			return simplifyMul(ny, simplifyMul(x, by.Y))
+		}
+	}
+
+	return mul(x, y)
+}
+
+// simplifyDiv returns x / y without dividing by one or by x itself, where a negation is taken out
+func simplifyDiv(x, y Expr) Expr {
+	switch {
+	case isNum(x, 0) && !isNum(y, 0):
+		return NewNum(0)
+	case isNum(y, 1):
+		return x
+	case !isNum(y, 0) && x.String() == y.String():
+		return NewNum(1)
+	}
+
+	if isNegative(x) {
+		return negate(simplifyDiv(negate(x), y))
+	}
+
+	if isNegative(y) {
+		return negate(simplifyDiv(x, negate(y)))
+	}
+
+	return div(x, y)
+}
+
+// simplifyPow returns x ^ y without the powers whose result is known
+func simplifyPow(x, y Expr) Expr {
+	switch {
+	case isNum(y, 0), isNum(x, 1):
+		return NewNum(1)
+	case isNum(y, 1):
+		return x
+	}
+	return pow(x, y)
+}
+
+// negate returns -x, where a number changes its sign and a negation is undone
+func negate(x Expr) Expr {
+	switch x := x.(type) {
+	case Num:
+		return Num{value: new(big.Rat).Neg(x.value)}
+	case Neg:
+		return x.X
+	}
+	return Neg{X: x}
+}
+
+// root returns √x, which is folded into a number if x is the square of one
+func root(x Expr) Expr {
+	n, ok := x.(Num)
+	if !ok || n.Sign() < 0 {
+		return Root{X: x}
+	}
+
+	num, den := new(big.Int).Sqrt(n.value.Num()), new(big.Int).Sqrt(n.value.Denom())
+	r := new(big.Rat).SetFrac(num, den)
+
+	if new(big.Rat).Mul(r, r).Cmp(n.value) != 0 {
+		return Root{X: x}
+	}
+	return Num{value: r}
+}
+
+// fold returns the operation of the numbers x and y as one number and true
+// if the result is exact and has a finite decimal expansion, otherwise returns false,
+// like for an uncertainty, which is not one number
+func fold(op data.TokenKind, x, y *big.Rat) (Num, bool) {
+	switch op {
+	case data.AddToken:
+		return Num{value: new(big.Rat).Add(x, y)}, true
+
+	case data.SubToken:
+		return Num{value: new(big.Rat).Sub(x, y)}, true
+
+	case data.MulToken:
+		return Num{value: new(big.Rat).Mul(x, y)}, true
+
+	case data.DivToken:
+		if y.Sign() == 0 {
+			return Num{}, false
+		}
+		return newNum(new(big.Rat).Quo(x, y))
+
+	case data.ModToken:
+		if y.Sign() == 0 {
+			return Num{}, false
+		}
+
+		q := new(big.Rat).Quo(x, y)
+		trunc := new(big.Rat).SetInt(new(big.Int).Quo(q.Num(), q.Denom()))
+		return Num{value: new(big.Rat).Sub(x, trunc.Mul(trunc, y))}, true
+
+	case data.PowToken:
+		return foldPow(x, y)
+	}
+
+	return Num{}, false
+}
+
+// foldPow returns x^y as one number and true if y is an integer and the result is not too big,
+// otherwise returns false
+func foldPow(x, y *big.Rat) (Num, bool) {
+	if !y.IsInt() || !y.Num().IsInt64() {
+		return Num{}, false
+	}
+
+	n := y.Num().Int64()
+	if n < 0 && x.Sign() == 0 {
+		return Num{}, false
+	}
+
+	size := int64(x.Num().BitLen() + x.Denom().BitLen())
+	if n > maxFoldBits || n < -maxFoldBits || size*abs(n) > maxFoldBits {
+		return Num{}, false
+	}
+
+	num := new(big.Int).Exp(x.Num(), big.NewInt(abs(n)), nil)
+	den := new(big.Int).Exp(x.Denom(), big.NewInt(abs(n)), nil)
+
+	if n < 0 {
+		num, den = den, num
+	}
+	return newNum(new(big.Rat).SetFrac(num, den))
+}
+
+// isNum returns true if the node is the number n, otherwise returns false
+func isNum(e Expr, n int64) bool {
+	x, ok := e.(Num)
+	return ok && x.value.Cmp(big.NewRat(n, 1)) == 0
+}
+
+// abs returns the absolute value of n
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
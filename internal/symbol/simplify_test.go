@@ -0,0 +1,95 @@
+package symbol
+
+import (
+	gomath "math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "x*1 + 0", want: "x"},
+		{expr: "(2+3)*x", want: "5*x"},
+		{expr: "0 - x^1", want: "-x"},
+		{expr: "0.1 + 0.2", want: "0.3"},
+		{expr: "1 / 4", want: "0.25"},
+		{expr: "1 / 3", want: "1/3"},
+		{expr: "2^-2", want: "0.25"},
+		{expr: "√(9/4)", want: "1.5"},
+		{expr: "√2", want: "√2"},
+		{expr: "7.5 % -2", want: "1.5"},
+		{expr: "x * 2 * (3 * y)", want: "6*x*y"},
+		{expr: "x - -y", want: "x + y"},
+		{expr: "sin(x) / sin(x)", want: "1"},
+		{expr: "x^0 + y*0", want: "1"},
+		{expr: "√0", want: "0"},
+		{expr: "y^(x mod 0.5*3.5)", want: "y^(3.5*(x mod 0.5))"},
+		{expr: "2*(x mod 3)*y", want: "2*(x mod 3*y)"},
+		{expr: "(1 + 2) ± (0.5*2)", want: "3 ± 1"},
+	}
+
+	for _, tt := range tests {
+		got := Simplify(parse(t, tt.expr))
+		assert.Equalf(t, tt.want, got.String(), "%s", tt.expr)
+		assert.Equalf(t, tt.want, Simplify(got).String(), "%s is not idempotent", tt.expr)
+		assert.InDeltaf(t, valueOf(t, tt.expr), valueOf(t, got.String()), 1e-9, "%s does not keep its value", tt.expr)
+	}
+}
+
+func TestSimplifyRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 2000; i++ {
+		expr := randomExpr(r, 4)
+		e := parse(t, expr)
+
+		for name, f := range map[string]func(Expr) Expr{"Simplify": Simplify, "Normalize": Normalize} {
+			got := f(e).String()
+			assert.Equalf(t, got, f(f(e)).String(), "%s(%s) is not idempotent", name, expr)
+			if name == "Normalize" {
+				assert.Equalf(t, got, f(parse(t, got)).String(), "%s(%s) is not canonical", name, expr)
+			}
+
+			want, err1 := evaluate(expr)
+			res, err2 := evaluate(got)
+			if err1 != nil || err2 != nil || gomath.IsNaN(want) || gomath.IsInf(want, 0) || gomath.Abs(want) > 1e6 {
+				continue
+			}
+			assert.InDeltaf(t, want, res, 1e-6*gomath.Max(1, gomath.Abs(want)), "%s(%s) = %s", name, expr, got)
+		}
+	}
+}
+
+// randomExpr returns a random expression of x, y, π and small numbers up to the given depth
+func randomExpr(r *rand.Rand, depth int) string {
+	leaves := []string{"x", "y", "π", "2", "3", "0.5", "3.5", "0", "1"}
+	if depth == 0 || r.Intn(4) == 0 {
+		return leaves[r.Intn(len(leaves))]
+	}
+
+	x, y := randomExpr(r, depth-1), randomExpr(r, depth-1)
+	switch r.Intn(9) {
+	case 0:
+		return "(" + x + " + " + y + ")"
+	case 1:
+		return "(" + x + " - " + y + ")"
+	case 2:
+		return x + "*" + y
+	case 3:
+		return "(" + x + ")/(" + y + ")"
+	case 4:
+		return "(" + x + " mod " + y + ")"
+	case 5:
+		return "(" + x + ")^2"
+	case 6:
+		return "√(" + x + ")"
+	case 7:
+		return "sin(" + x + ")"
+	}
+	return "-(" + x + ")"
+}
@@ -1,44 +1,233 @@
 package tokenize
 
 import (
+	"strings"
+	"unicode/utf8"
+
 	"github.com/brianlewyn/go-calculator/ierr"
 	"github.com/brianlewyn/go-calculator/internal/data"
 	"github.com/brianlewyn/go-calculator/internal/doubly"
 )
 
+// wrapperTokens is the number of tokens added around a number with a suffix:
+//
+//	3 km => (3*km)
+const wrapperTokens = 3
+
 var (
 	left  = data.NewSymbolToken(data.LeftToken)
 	right = data.NewSymbolToken(data.RightToken)
 )
 
+// Options represents the settings to tokenize an expression
+type Options struct {
+	// Percent turns each '%' into a postfix percentage,
+	// leaving the keyword 'mod' as the modulo
+	Percent bool
+
+	// Complex turns a number followed by the imaginary unit into a product
+	//
+	//	2i => (2*i)
+	Complex bool
+
+	// Units turns the names of units into UnitTokens, a number followed by a unit
+	// into a product, and the keywords 'to' and 'in' into a conversion to a unit
+	//
+	//	3 km/h => (3*km)/h
+	//	5 ft to m => (5*ft)→m
+	Units bool
+
+	// Money turns a number preceded by a currency symbol or followed by a currency code
+	// into a product, and the keywords 'to' and 'in' into a conversion to a currency
+	//
+	//	$12.50 => (12.50*USD)
+	//	12 EUR to USD => (12*EUR)→USD
+	Money bool
+
+	// Dates turns the date and time literals into DateTokens,
+	// and a number followed by a unit of time into a product
+	//
+	//	2026-10-18 + 90 days => d+(n*days)
+	//	17:45 - 09:10 => d-d
+	Dates bool
+
+	// Observe is called with each token of the list once it is tokenized, and it can be nil
+	Observe func(token data.Token)
+
+	// Count is called with the number of tokens of the expression as it is written, without the ones
+	// added to tokenize it, like the zero of a negation, the parentheses and the multiplication
+	// around 3 km or 2i, or the one that marks an array, and it can be nil
+	Count func(tokens int)
+}
+
 // Tokenizer returns the expression in an Tokenized Linked List and nil,
 // otherwise returns nil and an error
 func Tokenizer(expression string) (*doubly.Doubly, error) {
+	return TokenizerWith(expression, Options{})
+}
+
+// TokenizerWith returns the expression tokenized with the given options
+// in an Tokenized Linked List and nil, otherwise returns nil and an error
+func TokenizerWith(expression string, opts Options) (*doubly.Doubly, error) {
 	if expression == "" {
 		return nil, ierr.EmptyField
 	}
 
-	list, err := toTokenizedLinkedList(expression)
+	list, err := toTokenizedLinkedList(expression, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	rebuildTokenizedLinkedList(list)
+
+	if opts.Observe != nil {
+		for temp := list.Head(); temp != nil; temp = temp.Next() {
+			opts.Observe(temp.Token())
+		}
+	}
 	return list, nil
 }
 
 // toTokenizedLinkedList returns the expression in a raw Tokenized Linked List and nil,
 // otherwise returns nil and an error
-func toTokenizedLinkedList(expression string) (*doubly.Doubly, error) {
-	k, list := 0, doubly.New()
+func toTokenizedLinkedList(expression string, opts Options) (*doubly.Doubly, error) {
+	k, list, added := 0, doubly.New(), 0
+	var opens []rune
 
 	for i, r := range expression {
+		if i < k {
+			continue
+		}
+
+		if opts.Dates && data.IsNumber(r) {
+			if literal := getFullDate(expression[i:]); literal != "" {
+				if _, _, ok := data.ParseDate(literal); !ok {
+					return nil, ierr.DateMisspelled(literal)
+				}
+
+				list.PushBack(data.NewWordToken(data.DateToken, literal))
+				k = i + len(literal)
+				continue
+			}
+		}
+
 		if data.IsDecimal(r) {
-			if i >= k {
-				num := getFullNumber(expression[i:])
-				list.PushBack(data.NewNumberToken(num))
-				k = i + len(num)
+			num := getFullNumber(expression[i:])
+			k = i + len(num)
+
+			if word := getFullWord(expression[k:]); opts.Complex && data.IsImaginary(word) {
+				pushImaginaryNumber(list, num, word)
+				added += wrapperTokens
+				k += len(word)
+				continue
+			}
+
+			if suffix := getWordSuffix(expression[k:], data.IsDuration); opts.Dates && suffix != "" {
+				pushWordNumber(list, num, data.DurationToken, strings.TrimLeft(suffix, string(data.Gap)))
+				added += wrapperTokens
+				k += len(suffix)
+				continue
+			}
+
+			if suffix := getWordSuffix(expression[k:], data.IsCurrency); opts.Money && suffix != "" {
+				pushWordNumber(list, num, data.CurrencyToken, strings.TrimLeft(suffix, string(data.Gap)))
+				added += wrapperTokens
+				k += len(suffix)
+				continue
+			}
+
+			if suffix := getUnitSuffix(expression[k:]); opts.Units && suffix != "" {
+				pushUnitNumber(list, num, suffix)
+				added += wrapperTokens
+				k += len(suffix)
+				continue
+			}
+
+			list.PushBack(data.NewNumberToken(num))
+			continue
+		}
+
+		if data.IsLetter(r) {
+			word := getFullWord(expression[i:])
+			k = i + len(word)
+
+			if kind, ok := data.KeywordMap[word]; ok {
+				list.PushBack(data.NewSymbolToken(kind))
+				continue
+			}
+
+			kind := getWordKind(expression[k:])
+
+			if isConversion(word, expression[k:], opts) {
+				target := getUnitTarget(expression[k:])
+				if target == "" {
+					return nil, getMissingTarget(expression[k:])
+				}
+
+				token, err := getConversionTarget(target, opts)
+				if err != nil {
+					return nil, err
+				}
+
+				list.PushBack(data.NewSymbolToken(data.ConvertToken))
+				list.PushBack(token)
+				k += len(target)
+				continue
+			}
+
+			if opts.Units && kind == data.VarToken && data.IsUnit(word) {
+				kind = data.UnitToken
 			}
+
+			list.PushBack(data.NewWordToken(kind, word))
+			continue
+		}
+
+		if code, ok := data.CurrencySymbolMap[r]; ok && opts.Money {
+			start := i + len(string(r))
+
+			num := getFullNumber(expression[start:])
+			if num == "" {
+				return nil, ierr.RuneUnknown(r, i)
+			}
+
+			pushWordNumber(list, num, data.CurrencyToken, code)
+			added += wrapperTokens
+			k = start + len(num)
+			continue
+		}
+
+		if _, ok := data.SeriesMap[r]; ok {
+			list.PushBack(data.NewWordToken(data.FuncToken, string(r)))
+			continue
+		}
+
+		if r == data.Mod && opts.Percent {
+			list.PushBack(data.NewSymbolToken(data.PercentToken))
+			continue
+		}
+
+		if r == data.Left || r == data.LeftBracket {
+			opens = append(opens, r)
+		}
+
+		if r == data.Right || r == data.RightBracket {
+			var err error
+			opens, err = closeParenthesis(opens, r)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if r == data.LeftBracket {
+			list.PushBack(data.NewSymbolToken(data.ArrayToken))
+			list.PushBack(left)
+			added++
+			continue
+		}
+
+		if r == data.RightBracket {
+			list.PushBack(right)
 			continue
 		}
 
@@ -56,6 +245,9 @@ func toTokenizedLinkedList(expression string) (*doubly.Doubly, error) {
 		return nil, ierr.EmptyField
 	}
 
+	if opts.Count != nil {
+		opts.Count(list.Size() - added)
+	}
 	return list, nil
 }
 
@@ -70,7 +262,7 @@ func rebuildTokenizedLinkedList(list *doubly.Doubly) {
 		}
 
 		if areLeftAndSubTokenTogether(temp) {
-			zero := data.NewNumberToken("0")
+			zero := data.NewZeroToken()
 			list.ConnectAfterNode(temp, doubly.NewNode(zero))
 			continue
 		}
@@ -92,7 +284,7 @@ func rebuildTokenizedLinkedList(list *doubly.Doubly) {
 	}
 
 	if isKind(list.Head(), data.SubToken) {
-		list.PushFront(data.NewNumberToken("0"))
+		list.PushFront(data.NewZeroToken())
 	}
 }
 
@@ -109,6 +301,295 @@ func getFullNumber(expression string) string {
 	return expression[0:]
 }
 
+// getFullWord returns a full variable or function name
+func getFullWord(expression string) string {
+	for i, r := range expression {
+		if data.IsWord(r) {
+			continue
+		}
+		return expression[0:i]
+	}
+	return expression[0:]
+}
+
+// pushImaginaryNumber adds a number multiplied by the imaginary unit wrapped in parentheses
+//
+//	2i => (2*i)
+func pushImaginaryNumber(list *doubly.Doubly, num, unit string) {
+	list.PushBack(left)
+	list.PushBack(data.NewNumberToken(num))
+	list.PushBack(data.NewSymbolToken(data.MulToken))
+	list.PushBack(data.NewWordToken(data.VarToken, unit))
+	list.PushBack(right)
+}
+
+// getUnitSuffix returns the gaps, the unit and its integer power that follow a number,
+// otherwise returns an empty string
+//
+//	3 km => " km"
+//	3 m^2 => " m^2"
+//	3 m ^ 2 => " m ^ 2"
+func getUnitSuffix(rest string) string {
+	gaps := countGaps(rest)
+	word := getFullWord(rest[gaps:])
+	suffix := rest[:gaps+len(word)]
+
+	if data.IsKeyword(word) || !data.IsUnit(word) || getWordKind(rest[len(suffix):]) == data.FuncToken {
+		return ""
+	}
+
+	if data.IsConversion(word) && getUnitTarget(rest[len(suffix):]) != "" {
+		return ""
+	}
+
+	return suffix + getUnitPower(rest[len(suffix):])
+}
+
+// getUnitPower returns the gaps, the power sign and the integer power that follow a unit,
+// otherwise returns an empty string
+//
+//	^2 + 1 => "^2"
+//	 ^ -2 => " ^ -2"
+func getUnitPower(rest string) string {
+	k := countGaps(rest)
+	if !strings.HasPrefix(rest[k:], string(data.Pow)) {
+		return ""
+	}
+
+	k += len(string(data.Pow))
+	k += countGaps(rest[k:])
+	if strings.HasPrefix(rest[k:], string(data.Sub)) {
+		k += len(string(data.Sub))
+	}
+
+	digits := getFullNumber(rest[k:])
+	if digits == "" || strings.ContainsRune(digits, data.Dot) {
+		return ""
+	}
+
+	return rest[:k+len(digits)]
+}
+
+// pushUnitNumber adds a number multiplied by the unit of the suffix wrapped in parentheses
+//
+//	3 km => (3*km)
+//	3 m^-2 => (3*m^-2)
+func pushUnitNumber(list *doubly.Doubly, num, suffix string) {
+	unit, power, _ := strings.Cut(strings.ReplaceAll(suffix, string(data.Gap), ""), string(data.Pow))
+
+	list.PushBack(left)
+	list.PushBack(data.NewNumberToken(num))
+	list.PushBack(data.NewSymbolToken(data.MulToken))
+	list.PushBack(data.NewWordToken(data.UnitToken, unit))
+
+	if power != "" {
+		list.PushBack(data.NewSymbolToken(data.PowToken))
+		if digits, ok := strings.CutPrefix(power, string(data.Sub)); ok {
+			list.PushBack(data.NewSymbolToken(data.SubToken))
+			power = digits
+		}
+		list.PushBack(data.NewNumberToken(power))
+	}
+
+	list.PushBack(right)
+}
+
+// getFullDate returns a full date or time literal with the shape of one of data.DateLayouts,
+// otherwise returns an empty string
+//
+//	2026-10-18 + 1 => 2026-10-18
+//	17:45:30 => 17:45:30
+func getFullDate(expression string) string {
+	var literal string
+
+	for _, layout := range data.DateLayouts {
+		if len(layout) > len(literal) && hasShape(expression, layout) {
+			literal = expression[:len(layout)]
+		}
+	}
+
+	return literal
+}
+
+// hasShape returns true if the expression starts with digits wherever the layout has them
+// and with the same separators, otherwise returns false
+func hasShape(expression, layout string) bool {
+	if len(expression) < len(layout) {
+		return false
+	}
+
+	for i := 0; i < len(layout); i++ {
+		if data.IsNumber(rune(layout[i])) != data.IsNumber(rune(expression[i])) {
+			return false
+		}
+		if !data.IsNumber(rune(layout[i])) && layout[i] != expression[i] {
+			return false
+		}
+	}
+
+	return len(expression) == len(layout) || !data.IsWord(rune(expression[len(layout)]))
+}
+
+// getWordSuffix returns the gaps and the word that follow a number if isSuffix accepts the word,
+// otherwise returns an empty string
+//
+//	12 EUR => " EUR"
+//	90 days => " days"
+func getWordSuffix(rest string, isSuffix func(word string) bool) string {
+	gaps := countGaps(rest)
+	word := getFullWord(rest[gaps:])
+
+	if !isSuffix(word) || getWordKind(rest[gaps+len(word):]) == data.FuncToken {
+		return ""
+	}
+
+	return rest[:gaps+len(word)]
+}
+
+// pushWordNumber adds a number multiplied by the word of the given kind wrapped in parentheses
+//
+//	12 EUR => (12*EUR)
+//	90 days => (90*days)
+func pushWordNumber(list *doubly.Doubly, num string, kind data.TokenKind, word string) {
+	list.PushBack(left)
+	list.PushBack(data.NewNumberToken(num))
+	list.PushBack(data.NewSymbolToken(data.MulToken))
+	list.PushBack(data.NewWordToken(kind, word))
+	list.PushBack(right)
+}
+
+// getConversionTarget returns the token of the currency or the unit of a conversion and nil,
+// otherwise returns nil and an error
+func getConversionTarget(target string, opts Options) (data.Token, error) {
+	if code := strings.TrimSpace(target); opts.Money && data.IsCurrency(code) {
+		return data.NewWordToken(data.CurrencyToken, code), nil
+	}
+
+	unit, ok := data.ParseUnit(target)
+	if !ok || !opts.Units {
+		return nil, ierr.UnitUnknown(strings.TrimSpace(target))
+	}
+
+	return data.NewWordToken(data.UnitToken, unit.Name), nil
+}
+
+// getUnitTarget returns the gaps and the unit or the currency of a conversion, which is
+// a product or a quotient of units with integer powers that ends before anything else,
+// like an operator, otherwise returns an empty string
+//
+//	to km/h) => " km/h"
+//	to m + 1 => " m"
+//	to USD, => " USD"
+func getUnitTarget(rest string) string {
+	target := getUnitTerm(rest)
+
+	for target != "" {
+		k := len(target) + countGaps(rest[len(target):])
+		if !strings.HasPrefix(rest[k:], string(data.Mul)) && !strings.HasPrefix(rest[k:], string(data.Div)) {
+			break
+		}
+
+		term := getUnitTerm(rest[k+1:])
+		if term == "" {
+			break
+		}
+		target = rest[:k+1+len(term)]
+	}
+
+	if strings.TrimLeft(target, string(data.Gap)) == "1" {
+		return ""
+	}
+	return target
+}
+
+// getUnitTerm returns the gaps, the unit or the currency and its integer power that start the rest,
+// where 1 is the numerator of a quotient like 1/s and in is not a unit if it converts to another one,
+// otherwise returns an empty string
+//
+//	m^2/s => "m^2"
+//	in in cm => ""
+func getUnitTerm(rest string) string {
+	gaps := countGaps(rest)
+	word := getFullWord(rest[gaps:])
+
+	if !data.IsUnit(word) && !data.IsCurrency(word) && word != "1" {
+		return ""
+	}
+
+	term := rest[:gaps+len(word)]
+	if data.IsConversion(word) && getUnitTarget(rest[len(term):]) != "" {
+		return ""
+	}
+	return term + getUnitPower(rest[len(term):])
+}
+
+// getMissingTarget returns the error of a conversion that is not followed by a unit or a currency
+func getMissingTarget(rest string) error {
+	rest = strings.TrimLeft(rest, string(data.Gap))
+	if rest == "" {
+		return ierr.KindEnd(data.Convert)
+	}
+
+	if word := getFullWord(rest); word != "" {
+		return ierr.UnitUnknown(word)
+	}
+
+	r, _ := utf8.DecodeRuneInString(rest)
+	return ierr.KindNotTogether(data.Convert, r)
+}
+
+// isConversion returns true if the word converts to the unit or the currency that follows it,
+// where in is the unit inch in the units mode if no unit or currency follows it, otherwise returns false
+func isConversion(word, rest string, opts Options) bool {
+	if !(opts.Units || opts.Money) || !data.IsConversion(word) {
+		return false
+	}
+	return !opts.Units || !data.IsUnit(word) || getUnitTarget(rest) != ""
+}
+
+// countGaps returns the number of gaps that start the rest
+func countGaps(rest string) int {
+	return len(rest) - len(strings.TrimLeft(rest, string(data.Gap)))
+}
+
+// closeParenthesis returns the parentheses and brackets still open after r closes the last one and nil,
+// otherwise returns nil and an error if r does not close the last one, where a right parenthesis
+// without a left one is left to the analyser
+//
+//	[(n) => [
+//	[n) => error
+func closeParenthesis(opens []rune, r rune) ([]rune, error) {
+	n := len(opens)
+	if n == 0 && r == data.Right {
+		return opens, nil
+	}
+
+	if n == 0 {
+		return nil, ierr.IncompleteRight
+	}
+
+	if (opens[n-1] == data.LeftBracket) != (r == data.RightBracket) {
+		return nil, ierr.KindNotTogether(opens[n-1], r)
+	}
+
+	return opens[:n-1], nil
+}
+
+// getWordKind returns FuncToken if the rest of the expression opens a parenthesis,
+// otherwise returns VarToken
+func getWordKind(rest string) data.TokenKind {
+	for _, r := range rest {
+		if r == data.Gap {
+			continue
+		}
+		if r == data.Left {
+			return data.FuncToken
+		}
+		break
+	}
+	return data.VarToken
+}
+
 // areRightAndLeftTokenTogether returns true there are a RightToken and a LeftToken together
 //
 //	)( => )*(
@@ -119,11 +600,15 @@ func areRightAndLeftTokenTogether(node *doubly.Node) bool {
 	return isKind(node.Next(), data.LeftToken)
 }
 
-// areLeftAndSubTokenTogether returns true there are a LeftToken and a SubToken together
+// areLeftAndSubTokenTogether returns true there are a LeftToken or a CommaToken and a SubToken together
 //
 //	(- => (0-
+//	,- => ,0-
 func areLeftAndSubTokenTogether(node *doubly.Node) bool {
-	if !isKind(node, data.LeftToken) || node.Next() == nil {
+	if !isKind(node, data.LeftToken) && !isKind(node, data.CommaToken) {
+		return false
+	}
+	if node.Next() == nil {
 		return false
 	}
 	return isKind(node.Next(), data.SubToken)
@@ -132,13 +617,13 @@ func areLeftAndSubTokenTogether(node *doubly.Node) bool {
 // canRemoveNextAddToken returns true if AddToken at the next index
 // can be removed according to the following rules:
 //
-//	# = { %, *, +, -, /, ^, √, ( }
+//	# = { %, *, +, -, /, ^, √, (, , }
 //
-//	From: #+n, #+π, #+(, #+√n, #+√π, #+√(...)
-//	To: #n, #π, #(, #√n, #√π, #√(...)
+//	From: #+n, #+π, #+(, #+f(, #+√n, #+√π, #+√(...)
+//	To: #n, #π, #(, #f(, #√n, #√π, #√(...)
 func canRemoveNextAddToken(node *doubly.Node) bool {
 	if !isKindFn(node, data.IsSpecialToken) {
-		if !isKind(node, data.LeftToken) {
+		if !isKind(node, data.LeftToken) && !isKind(node, data.CommaToken) {
 			return false
 		}
 	}
@@ -162,8 +647,8 @@ func canRemoveNextAddToken(node *doubly.Node) bool {
 		return true
 	}
 
-	// #+(...)
-	if isKind(temp, data.LeftToken) {
+	// #+(...), #+f(...)
+	if isKindFn(temp, isLeftOrFuncToken) {
 		return true
 	}
 
@@ -179,8 +664,8 @@ func canRemoveNextAddToken(node *doubly.Node) bool {
 //
 //	# = {%, *, +, -, /, ^, √}
 //
-//	From: #-n, #-π, #-(, #-√n, #-√π, #-√(...)
-//	To: #(-n), #(-π), #(-(...)), #(-√n) #(-√π) #(-√(...))
+//	From: #-n, #-π, #-(, #-f(, #-√n, #-√π, #-√(...)
+//	To: #(-n), #(-π), #(-(...)), #(-f(...)), #(-√n) #(-√π) #(-√(...))
 func canWrapNextSubToken(node *doubly.Node, list *doubly.Doubly) bool {
 	if !isKindFn(node, data.IsSpecialToken) {
 		return false
@@ -206,8 +691,8 @@ func canWrapNextSubToken(node *doubly.Node, list *doubly.Doubly) bool {
 		return true
 	}
 
-	// #-(...)
-	if isKind(temp, data.LeftToken) {
+	// #-(...), #-f(...)
+	if isKindFn(temp, isLeftOrFuncToken) {
 		wrapWithOtherParentheses(node, list)
 		return true
 	}
@@ -228,8 +713,8 @@ func canWrapNextSubToken(node *doubly.Node, list *doubly.Doubly) bool {
 		return true
 	}
 
-	// #-√(...)
-	if isKind(temp, data.LeftToken) {
+	// #-√(...), #-√f(...)
+	if isKindFn(temp, isLeftOrFuncToken) {
 		wrapWithOtherParentheses(node, list)
 		return true
 	}
@@ -269,8 +754,8 @@ func canWrapNextRootToken(node *doubly.Node, list *doubly.Doubly) {
 			return
 		}
 
-		// √√(...), √√√(...), ...
-		if isKind(temp, data.LeftToken) {
+		// √√(...), √√√(...), ...; √√f(...), √√√f(...), ...
+		if isKindFn(temp, isLeftOrFuncToken) {
 			wrapWithOtherParentheses(node, list)
 			return
 		}
@@ -308,10 +793,17 @@ func wrapWithOtherParentheses(node *doubly.Node, list *doubly.Doubly) {
 
 // isKind returns true if the node's kind is equal to the given kind, otherwise returns false
 func isKind(node *doubly.Node, token data.TokenKind) bool {
-	return node.Token().Kind() == token
+	return node != nil && node.Token().Kind() == token
+}
+
+// isLeftOrFuncToken returns true if kind is:
+//
+//	(, f, [
+func isLeftOrFuncToken(kind data.TokenKind) bool {
+	return kind == data.LeftToken || kind == data.FuncToken || kind == data.ArrayToken
 }
 
 // isKindFn returns true if node's kind is equal to the given kind of a function, otherwise returns false
 func isKindFn(node *doubly.Node, tokenFn func(token data.TokenKind) bool) bool {
-	return tokenFn(node.Token().Kind())
+	return node != nil && tokenFn(node.Token().Kind())
 }
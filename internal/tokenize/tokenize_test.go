@@ -28,14 +28,167 @@ func TestTokenizer(t *testing.T) {
 		gotList, err := Tokenizer("+(0 - 1 + 2 * 3 / 4 ^ 5 % 6 + √π)(-1.234)")
 		assert.Nil(t, err, "error != nil")
 
-		wantList := toList("(0-1+2*3/4^5%6+√π)*(0-1.234)")
+		wantList := toList("(0-1+2*3/4^5%6+√π)*(~-1.234)")
 		areEqualList(t, gotList, wantList)
 	})
+
+	t.Run("From an expression with names to a linked list", func(t *testing.T) {
+		gotList, err := Tokenizer("f (x1, -y) * -g(2)")
+		assert.Nil(t, err, "error != nil")
+
+		assert.Equal(t, "f(v,n-v)*(n-f(n))", toString(gotList))
+		assert.Equal(t, data.NewWordToken(data.FuncToken, "f"), gotList.Head().Token())
+		assert.Equal(t, data.NewWordToken(data.VarToken, "x1"), gotList.Head().Next().Next().Token())
+	})
+
+	t.Run("From an expression to an observed linked list", func(t *testing.T) {
+		var kinds []data.TokenKind
+		observe := func(token data.Token) {
+			kinds = append(kinds, token.Kind())
+		}
+
+		gotList, err := TokenizerWith("-x * 2", Options{Observe: observe})
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "n-v*n", toString(gotList))
+		assert.Equal(t, []data.TokenKind{data.NumToken, data.SubToken, data.VarToken, data.MulToken, data.NumToken}, kinds)
+	})
+
+	t.Run("From an expression to the number of tokens as it is written", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			opts Options
+			want int
+		}{
+			{expr: "-1", want: 2},
+			{expr: "2^-3", want: 4},
+			{expr: "[1, (2)] + √-4", want: 11},
+			{expr: "2i - 1", opts: Options{Complex: true}, want: 4},
+			{expr: "3 km^2 to m^2", opts: Options{Units: true}, want: 6},
+			{expr: "$12.50 + 3 EUR", opts: Options{Money: true}, want: 5},
+		}
+
+		for _, tt := range tests {
+			got := 0
+			tt.opts.Count = func(tokens int) {
+				got = tokens
+			}
+
+			_, err := TokenizerWith(tt.expr, tt.opts)
+			assert.Nilf(t, err, "%s: error != nil", tt.expr)
+			assert.Equalf(t, tt.want, got, "%s", tt.expr)
+		}
+	})
+
+	t.Run("From an expression with series to a linked list", func(t *testing.T) {
+		gotList, err := Tokenizer("Σ(k, k, 1, 3) * ∏(k, k, 1, 3)")
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "f(v,v,n,n)*f(v,v,n,n)", toString(gotList))
+		assert.Equal(t, data.NewWordToken(data.FuncToken, "Σ"), gotList.Head().Token())
+
+		gotList, err = Tokenizer("∏(k, k, 1, 3)")
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, data.NewWordToken(data.FuncToken, "∏"), gotList.Head().Token())
+	})
+
+	t.Run("From an expression with imaginary numbers to a linked list", func(t *testing.T) {
+		gotList, err := TokenizerWith("3 - 2i + 1.5j", Options{Complex: true})
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "n-(n*v)+(n*v)", toString(gotList))
+
+		_, err = TokenizerWith("2i", Options{})
+		assert.Nil(t, err, "error != nil")
+	})
+
+	t.Run("From an expression with units to a linked list", func(t *testing.T) {
+		gotList, err := TokenizerWith("3 km/h + 2m^-2 to m/s", Options{Units: true})
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "(n*u)/u+(n*u^(n-n))→u", toString(gotList))
+		assert.Equal(t, data.NewWordToken(data.UnitToken, "m/s"), gotList.Tail().Token())
+
+		gotList, err = TokenizerWith("min(2 s, s) + m", Options{})
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "f(nv,v)+v", toString(gotList))
+
+		gotList, err = TokenizerWith("2 m ^ 2 + 1 in in cm", Options{Units: true})
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "(n*u^n)+(n*u)→u", toString(gotList))
+		assert.Equal(t, data.NewWordToken(data.UnitToken, "in"), gotList.Tail().Prev().Prev().Prev().Token())
+
+		gotList, err = TokenizerWith("5 ft to m + 1 m", Options{Units: true})
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "(n*u)→u+(n*u)", toString(gotList))
+
+		gotList, err = TokenizerWith("5 ft in in", Options{Units: true})
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "(n*u)→u", toString(gotList))
+
+		_, err = TokenizerWith("5 ft to yards", Options{Units: true})
+		assert.True(t, ierr.As(err, ierr.CtxUnitUnknown), "err != CtxUnitUnknown")
+
+		_, err = TokenizerWith("5 ft to", Options{Units: true})
+		assert.True(t, ierr.As(err, ierr.CtxKindEnd), "err != CtxKindEnd")
+
+		_, err = TokenizerWith("5 ft to + 1", Options{Units: true})
+		assert.True(t, ierr.As(err, ierr.CtxKindNotTogether), "err != CtxKindNotTogether")
+	})
+
+	t.Run("From an expression with money to a linked list", func(t *testing.T) {
+		gotList, err := TokenizerWith("$12.50 - 3 EUR to MXN", Options{Money: true})
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "(n*¤)-(n*¤)→¤", toString(gotList))
+		assert.Equal(t, data.NewWordToken(data.CurrencyToken, "MXN"), gotList.Tail().Token())
+
+		_, err = TokenizerWith("$ 12", Options{Money: true})
+		assert.True(t, ierr.As(err, ierr.CtxRuneUnknown), "err != CtxRuneUnknown")
+	})
+
+	t.Run("From an expression with dates to a linked list", func(t *testing.T) {
+		gotList, err := TokenizerWith("2026-10-18 + 90 days - (17:45 - 09:10)", Options{Dates: true})
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "d+(n*t)-(d-d)", toString(gotList))
+		assert.Equal(t, data.NewWordToken(data.DateToken, "2026-10-18"), gotList.Head().Token())
+
+		_, err = TokenizerWith("2026-13-01", Options{Dates: true})
+		assert.True(t, ierr.As(err, ierr.CtxDateMisspelled), "err != CtxDateMisspelled")
+	})
+
+	t.Run("From an expression with arrays to a linked list", func(t *testing.T) {
+		gotList, err := Tokenizer("[1, -2] · [[3], [4]]")
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "[(n,n-n)·[([(n),[(n))", toString(gotList))
+
+		_, err = Tokenizer("[1, 2)")
+		assert.True(t, ierr.As(err, ierr.CtxKindNotTogether), "err != CtxKindNotTogether")
+
+		_, err = Tokenizer("1]")
+		assert.ErrorIs(t, err, ierr.IncompleteRight)
+	})
+
+	t.Run("From an expression with multiplication and division signs to a linked list", func(t *testing.T) {
+		gotList, err := Tokenizer("2 × 3 ÷ 4")
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "n*n/n", toString(gotList))
+	})
+
+	t.Run("From an expression with uncertainties to a linked list", func(t *testing.T) {
+		gotList, err := Tokenizer("2 * 9.81 ± 0.02 - x ± -1")
+		assert.Nil(t, err, "error != nil")
+		assert.Equal(t, "n*n±n-v±(n-n)", toString(gotList))
+	})
+
+	t.Run("From an expression with percentages to a linked list", func(t *testing.T) {
+		gotList, err := TokenizerWith("200 + 10% - 7 mod 4", Options{Percent: true})
+		assert.Nil(t, err, "error != nil")
+
+		assert.Equal(t, "n+n%-n%n", toString(gotList))
+		assert.Equal(t, data.PercentToken, gotList.Head().Next().Next().Next().Token().Kind())
+		assert.Equal(t, data.ModToken, gotList.Tail().Prev().Token().Kind())
+	})
 }
 
 func TestToTokenizedLinkedList(t *testing.T) {
 	t.Run("From an expression with some inappropriate symbols to a list", func(t *testing.T) {
-		gotList, err := toTokenizedLinkedList("12345 + hola + 12345")
+		gotList, err := toTokenizedLinkedList("12345 + #hola + 12345", Options{})
 		errRune := new(ierr.Rune)
 
 		assert.ErrorAsf(t, err, &errRune, "[err != Rune]: %v", err)
@@ -43,7 +196,7 @@ func TestToTokenizedLinkedList(t *testing.T) {
 	})
 
 	t.Run("From a filled expression to a list", func(t *testing.T) {
-		gotList, err := toTokenizedLinkedList("(0 - 1 + 2 * 3 / 4 ^ 5 % 6 + √π) - 1.234")
+		gotList, err := toTokenizedLinkedList("(0 - 1 + 2 * 3 / 4 ^ 5 % 6 + √π) - 1.234", Options{})
 		assert.Nil(t, err, "error != nil")
 
 		wantList := toList("(0-1+2*3/4^5%6+√π)-1.234")
@@ -55,30 +208,30 @@ func TestToTokenizedLinkedList(t *testing.T) {
 
 func TestRebuildTokenizedLinkedList(t *testing.T) {
 	t.Run("From a list to a list rebuilded", func(t *testing.T) {
-		gotList, err := toTokenizedLinkedList("-(+10)(-12)(*12)")
+		gotList, err := toTokenizedLinkedList("-(+10)(-12)(*12)", Options{})
 		assert.Nil(t, err, "error != nil")
 
 		rebuildTokenizedLinkedList(gotList)
-		wantList := toList("0-(10)*(0-12)*(*12)")
+		wantList := toList("~-(10)*(~-12)*(*12)")
 
 		areEqualList(t, gotList, wantList)
 		assert.Equal(t, gotList.Size(), wantList.Size(), "g.Size != w.Size")
 	})
 
 	t.Run("From a list to a list rebuilded (complex)(+)", func(t *testing.T) {
-		gotList, err := toTokenizedLinkedList("5^-2 - 5^(2^-(1/2) * 2^-√(π-8)) - 5*-√π --4")
+		gotList, err := toTokenizedLinkedList("5^-2 - 5^(2^-(1/2) * 2^-√(π-8)) - 5*-√π --4", Options{})
 		assert.Nil(t, err, "error != nil")
 
 		rebuildTokenizedLinkedList(gotList)
 
-		wantList := toList("5^(0-2)-5^(2^(0-(1/2))*2^(0-√(π-8)))-5*(0-√π)-(0-4)")
+		wantList := toList("5^(~-2)-5^(2^(~-(1/2))*2^(~-√(π-8)))-5*(~-√π)-(~-4)")
 		areEqualList(t, gotList, wantList)
 
 		assert.Equal(t, gotList.Size(), wantList.Size(), "g.Size != w.Size")
 	})
 
 	t.Run("From a list to a list rebuilded (complex)(-)", func(t *testing.T) {
-		gotList, err := toTokenizedLinkedList("5^+2 + 5^(2^+(1/2) * 2^+√(π+8)) + 5*+√π ++4")
+		gotList, err := toTokenizedLinkedList("5^+2 + 5^(2^+(1/2) * 2^+√(π+8)) + 5*+√π ++4", Options{})
 		assert.Nil(t, err, "error != nil")
 
 		rebuildTokenizedLinkedList(gotList)
@@ -90,7 +243,7 @@ func TestRebuildTokenizedLinkedList(t *testing.T) {
 	})
 
 	t.Run("From a list to a list rebuilded (bugs complex: single add)", func(t *testing.T) {
-		gotList, err := toTokenizedLinkedList("^+")
+		gotList, err := toTokenizedLinkedList("^+", Options{})
 		assert.Nil(t, err, "error != nil")
 
 		rebuildTokenizedLinkedList(gotList)
@@ -102,7 +255,7 @@ func TestRebuildTokenizedLinkedList(t *testing.T) {
 	})
 
 	t.Run("From a list to a list rebuilded (bugs complex: double add)", func(t *testing.T) {
-		gotList, err := toTokenizedLinkedList("^++")
+		gotList, err := toTokenizedLinkedList("^++", Options{})
 		assert.Nil(t, err, "error != nil")
 
 		rebuildTokenizedLinkedList(gotList)
@@ -114,7 +267,7 @@ func TestRebuildTokenizedLinkedList(t *testing.T) {
 	})
 
 	t.Run("From a list to a list rebuilded (bugs complex: *-√%)", func(t *testing.T) {
-		gotList, err := toTokenizedLinkedList("*-√%")
+		gotList, err := toTokenizedLinkedList("*-√%", Options{})
 		assert.Nil(t, err, "error != nil")
 
 		rebuildTokenizedLinkedList(gotList)
@@ -138,11 +291,19 @@ func areEqualList(t *testing.T, g, w *doubly.Doubly) {
 	}
 }
 
+// unaryZero is the rune of the zero written before a minus that is a negation in toList
+const unaryZero = '~'
+
 // toList returns the expression in a raw Tokenized Linked List
 func toList(expression string) *doubly.Doubly {
 	k, list := 0, doubly.New()
 
 	for i, r := range expression {
+		if r == unaryZero {
+			list.PushBack(data.NewZeroToken())
+			continue
+		}
+
 		if data.IsDecimal(r) {
 			if i >= k {
 				num := getFullNumber(expression[i:])